@@ -0,0 +1,65 @@
+package solar
+
+// Algorithm selects the model used to compute the position of the sun.
+//
+// The default, SunriseEquation, is the fast closed-form chain the package has
+// always used. It is accurate to a fraction of a degree, which is plenty for
+// sunrise and sunset times. NOAA reproduces the NOAA Solar Calculator. SPA
+// trades speed for accuracy and is suitable for solar tracking and other
+// applications that need sub-arcsecond positions.
+//
+// An algorithm can be chosen per observer with Location.WithAlgorithm, or per
+// call by passing it to functions that accept an optional algorithm, which
+// takes precedence over the observer's choice.
+type Algorithm int
+
+const (
+	// SunriseEquation uses the simplified sunrise equation with a fixed
+	// obliquity and a three-term equation of center. This is the default.
+	// Location.WithSecularTerms makes the orbital elements time-varying for
+	// dates far from 2000.
+	SunriseEquation Algorithm = iota
+
+	// SPA uses the NREL Solar Position Algorithm (Reda & Andreas, 2008),
+	// including nutation, aberration, the true obliquity of the ecliptic,
+	// delta-T and topocentric parallax. It is accurate to ±0.0003° for the
+	// years -2000 to 6000.
+	SPA
+
+	// NOAA uses the algorithm of the NOAA Solar Calculator and spreadsheet,
+	// with Julian-century polynomials for the geometric mean longitude,
+	// eccentricity, obliquity and equation of time. Rise and set times are
+	// recomputed from the sun's position at the first estimate, as the NOAA
+	// calculator does.
+	NOAA
+)
+
+// String returns the name of the algorithm.
+func (a Algorithm) String() string {
+	switch a {
+	case SPA:
+		return "SPA"
+	case NOAA:
+		return "NOAA"
+	default:
+		return "SunriseEquation"
+	}
+}
+
+// selectAlgorithm returns the first algorithm in the optional list, or the
+// location's algorithm when none is given.
+func selectAlgorithm(loc Location, algorithm []Algorithm) Algorithm {
+	if len(algorithm) > 0 {
+		return algorithm[0]
+	}
+	return loc.algorithm
+}
+
+// optionalAlgorithm returns the first algorithm in the optional list, or
+// SunriseEquation when none is given, for calculations without a location.
+func optionalAlgorithm(algorithm []Algorithm) Algorithm {
+	if len(algorithm) > 0 {
+		return algorithm[0]
+	}
+	return SunriseEquation
+}
@@ -0,0 +1,46 @@
+package solar
+
+import (
+	"testing"
+)
+
+// TestAlgorithmString tests the String method of Algorithm
+func TestAlgorithmString(t *testing.T) {
+	if SunriseEquation.String() != "SunriseEquation" {
+		t.Errorf("SunriseEquation.String() = %q", SunriseEquation.String())
+	}
+	if SPA.String() != "SPA" {
+		t.Errorf("SPA.String() = %q", SPA.String())
+	}
+	if NOAA.String() != "NOAA" {
+		t.Errorf("NOAA.String() = %q", NOAA.String())
+	}
+}
+
+// TestSelectAlgorithm tests the default and explicit algorithm selection
+func TestSelectAlgorithm(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	if a := selectAlgorithm(loc, nil); a != SunriseEquation {
+		t.Errorf("selectAlgorithm(nil) = %v, want SunriseEquation", a)
+	}
+	if a := selectAlgorithm(loc, []Algorithm{SPA}); a != SPA {
+		t.Errorf("selectAlgorithm(SPA) = %v, want SPA", a)
+	}
+
+	// The per-observer algorithm applies unless the call overrides it
+	loc = loc.WithAlgorithm(NOAA)
+	if a := selectAlgorithm(loc, nil); a != NOAA {
+		t.Errorf("selectAlgorithm(loc NOAA) = %v, want NOAA", a)
+	}
+	if a := selectAlgorithm(loc, []Algorithm{SunriseEquation}); a != SunriseEquation {
+		t.Errorf("selectAlgorithm(loc NOAA, SunriseEquation) = %v, want SunriseEquation", a)
+	}
+
+	// Without a location, the default is the sunrise equation
+	if a := optionalAlgorithm(nil); a != SunriseEquation {
+		t.Errorf("optionalAlgorithm(nil) = %v, want SunriseEquation", a)
+	}
+	if a := optionalAlgorithm([]Algorithm{NOAA}); a != NOAA {
+		t.Errorf("optionalAlgorithm(NOAA) = %v, want NOAA", a)
+	}
+}
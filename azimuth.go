@@ -1,77 +1,24 @@
 package solar
 
 import (
-	"math"
 	"time"
 )
 
-// azimuthInternal calculates the solar azimuth angle (internal function with old signature).
-func azimuthInternal(latitude, longitude float64, when time.Time) float64 {
-	// Calculate mean solar noon for the given day
-	meanNoon := meanSolarNoonInternal(longitude, when.Year(), when.Month(), when.Day())
-
-	// Calculate solar mean anomaly
-	solarAnomaly := meanAnomaly(meanNoon)
-
-	// Calculate equation of center
-	equationOfCenter := equationOfCenter(solarAnomaly)
-
-	// Calculate ecliptic longitude
-	eclipticLongitude := eclipticLongitude(solarAnomaly, equationOfCenter, meanNoon)
-
-	// Calculate solar transit
-	solarTransit := transit(meanNoon, solarAnomaly, eclipticLongitude)
-
-	// Calculate solar declination
-	declination := declination(eclipticLongitude)
-
-	// Calculate hour angle from the time
-	frac := TimeToJulianDay(when) - solarTransit
-	hourAngle := 2.0 * math.Pi * frac
-
-	// Get current solar elevation
-	elevation := elevationInternal(latitude, longitude, when)
-
-	// Calculate azimuth using spherical trigonometry
-	// Formula: cos(Az) = (sin(δ) * cos(φ) - cos(δ) * sin(φ) * cos(H)) / cos(h)
-	// Where:
-	//   Az = azimuth angle
-	//   δ = declination
-	//   φ = latitude
-	//   H = hour angle
-	//   h = elevation
-	latRad := latitude * Degree
-	declRad := declination * Degree
-	elevRad := elevation * Degree
-
-	firstPart := math.Sin(declRad) * math.Cos(latRad)
-	secondPart := math.Cos(declRad) * math.Sin(latRad) * math.Cos(hourAngle)
-	cosAzimuth := (firstPart - secondPart) / math.Cos(elevRad)
-
-	// Calculate azimuth in degrees
-	azimuth := math.Acos(cosAzimuth) / Degree
-
-	// Adjust for afternoon (hour angle >= 0 means afternoon/evening)
-	if hourAngle >= 0 {
-		azimuth = FullCircleDegrees - azimuth
-	}
-
-	return azimuth
-}
-
 // Azimuth calculates the solar azimuth angle at a specific time and location.
 // The azimuth is the sun's compass direction, measured clockwise from true north.
 //
 // Parameters:
 //   - loc: Location created via NewLocation() or NewLocationFromNMEA()
 //   - when: The datetime at which to calculate the azimuth (use time.Date, time.Now(), or Time.DateTime())
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
 //
 // Returns:
 //   - The solar azimuth angle in degrees (0° = North, 90° = East, 180° = South, 270° = West)
 //
 // The calculation uses the solar hour angle and declination to determine the sun's
 // position in the sky. The azimuth is measured clockwise from north, ranging from
-// 0° to 360°.
+// 0° to 360°. Use Position to obtain the azimuth together with the elevation and
+// the other solar coordinates in a single calculation.
 //
 // Note: All calculations assume UTC time. Ensure the input time is in UTC timezone.
 //
@@ -81,6 +28,6 @@ func azimuthInternal(latitude, longitude float64, when time.Time) float64 {
 //	when := time.Date(2000, time.January, 1, 17, 0, 0, 0, time.UTC)
 //	azimuth := solar.Azimuth(loc, when)
 //	// azimuth is in degrees: 0°=North, 90°=East, 180°=South, 270°=West
-func Azimuth(loc Location, when time.Time) float64 {
-	return azimuthInternal(loc.Latitude(), loc.Longitude(), when)
+func Azimuth(loc Location, when time.Time, algorithm ...Algorithm) float64 {
+	return Position(loc, when, algorithm...).Azimuth
 }
@@ -16,3 +16,24 @@ func equationOfCenter(solarAnomaly float64) float64 {
 	)
 	return EquationOfCenterC1*anomalySin + EquationOfCenterC2*anomaly2Sin + EquationOfCenterC3*anomaly3Sin
 }
+
+// eccentricity calculates the eccentricity of the earth's orbit for the given
+// number of Julian centuries since J2000.
+func eccentricity(t float64) float64 {
+	return 0.016708634 - t*(0.000042037+0.0000001267*t)
+}
+
+// equationOfCenterSecular calculates the equation of center for the given mean
+// anomaly from the orbit's eccentricity e, so that its coefficients follow the
+// slow change of the orbit. For the eccentricity at J2000 the coefficients are
+// those of the constant-based equationOfCenter.
+func equationOfCenterSecular(solarAnomaly, e float64) float64 {
+	var (
+		anomalyInRad = solarAnomaly * Degree
+		e2           = e * e
+		e3           = e2 * e
+	)
+	return ((2*e-e3/4)*math.Sin(anomalyInRad) +
+		1.25*e2*math.Sin(2*anomalyInRad) +
+		13.0/12*e3*math.Sin(3*anomalyInRad)) / Degree
+}
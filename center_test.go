@@ -24,3 +24,24 @@ func TestEquationOfCenter(t *testing.T) {
 		}
 	}
 }
+
+func TestEccentricity(t *testing.T) {
+	if v := eccentricity(0); v != 0.016708634 {
+		t.Errorf("eccentricity(0) = %f, want 0.016708634", v)
+	}
+	// The orbit is slowly becoming more circular
+	if eccentricity(10) >= eccentricity(-10) {
+		t.Errorf("eccentricity(10) = %f, want less than eccentricity(-10) = %f", eccentricity(10), eccentricity(-10))
+	}
+}
+
+// TestEquationOfCenterSecular checks that the coefficients derived from the
+// eccentricity at J2000 match the constants of the fast path.
+func TestEquationOfCenterSecular(t *testing.T) {
+	for _, tt := range dataEquationOfCenter {
+		v := equationOfCenterSecular(tt.in, eccentricity(0))
+		if !AlmostEqual(v, tt.out, 0.0005) {
+			t.Errorf("equationOfCenterSecular(%f) = %f, want %f", tt.in, v, tt.out)
+		}
+	}
+}
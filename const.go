@@ -58,6 +58,24 @@ const (
 	// Calculated as: -0.833 * (π/180) = -0.0145385927 radians
 	SunriseCorrectionAngle = -0.0145385927
 
+	// HorizonRefraction is the standard atmospheric refraction of the sun's
+	// center at the horizon (34 arc minutes, in degrees), for an atmosphere at
+	// StandardPressure and StandardTemperature.
+	HorizonRefraction = 34.0 / 60.0
+
+	// SolarSemidiameter is the mean apparent radius of the sun (16 arc minutes,
+	// in degrees). Sunrise and sunset are defined by the sun's upper limb.
+	// Location.WithVaryingSemidiameter uses the radius on the date instead.
+	SolarSemidiameter = 16.0 / 60.0
+
+	// StandardPressure is the atmospheric pressure, in millibars (hPa), assumed
+	// when an observer's pressure is not specified.
+	StandardPressure = 1010.0
+
+	// StandardTemperature is the air temperature, in degrees Celsius, assumed
+	// when an observer's temperature is not specified.
+	StandardTemperature = 10.0
+
 	// PerihelionBase is the argument of perihelion at J2000 epoch (in degrees).
 	// This is the angle from the vernal equinox to perihelion.
 	PerihelionBase = 102.93005
@@ -93,4 +111,24 @@ const (
 	// observations of point sources of light such as stars, though the Sun's light
 	// may still interfere with observations of extremely faint objects.
 	AstronomicalTwilightAngle = -18.0
+
+	// GoldenHourAngle is the solar elevation angle (6°) below which the golden
+	// hour begins. The low sun gives a warm, soft light favored by photographers.
+	GoldenHourAngle = 6.0
+
+	// BlueHourAngle is the solar elevation angle (-4°) at which the golden hour
+	// gives way to the blue hour. During the blue hour, down to the civil
+	// twilight angle, the sky takes on a deep blue color.
+	BlueHourAngle = -4.0
+
+	// Religious15TwilightAngle is the solar elevation angle (-15°) used by some
+	// religious calendars for the beginning of morning and the end of evening
+	// twilight, such as the Fajr and Isha prayer times of the Islamic Society of
+	// North America.
+	Religious15TwilightAngle = -15.0
+
+	// Religious19HalfTwilightAngle is the solar elevation angle (-19.5°) used by
+	// some religious calendars for the beginning of morning twilight, such as the
+	// Fajr prayer time of the Egyptian General Authority of Survey.
+	Religious19HalfTwilightAngle = -19.5
 )
@@ -0,0 +1,193 @@
+package solar
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// CrossingDirection tells whether the sun is rising or setting through an
+// elevation.
+type CrossingDirection int
+
+const (
+	// Rising means the sun climbs through the elevation.
+	Rising CrossingDirection = iota
+
+	// Setting means the sun sinks through the elevation.
+	Setting
+)
+
+// String returns the name of the direction.
+func (d CrossingDirection) String() string {
+	if d == Setting {
+		return "Setting"
+	}
+	return "Rising"
+}
+
+// Crossing is an instant at which the sun passes through an elevation.
+type Crossing struct {
+	// Time is the instant of the crossing.
+	Time time.Time
+
+	// Direction tells whether the sun is rising or setting.
+	Direction CrossingDirection
+}
+
+const (
+	// crossingStep is the interval, in days, at which the elevation is
+	// sampled when searching for crossings (10 minutes).
+	crossingStep = 1.0 / 144
+
+	// crossingTolerance is the precision, in days, to which crossings are
+	// located (about 0.1 ms).
+	crossingTolerance = 1e-9
+)
+
+// ElevationCrossings finds every instant within a time window at which the sun's
+// true elevation crosses the given elevation, each tagged Rising or Setting.
+//
+// Unlike TimeOfElevation, which assumes the sun crosses an elevation exactly
+// twice a day, the crossings are found by root finding on the elevation itself,
+// with the sun's position evaluated at each instant. This handles any number of
+// crossings: none during polar day or night, several over a multi-day window,
+// and the two close crossings when the sun only just dips below (or rises
+// above) the elevation near the polar circles. A sun that touches the elevation
+// without passing through it is not reported.
+//
+// Parameters:
+//   - loc: Location created via NewLocation(), NewObserver() or NewLocationFromNMEA()
+//   - elevation: Solar elevation angle in degrees (negative for below horizon)
+//   - start: Beginning of the window (inclusive)
+//   - end: End of the window (exclusive)
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
+//
+// Returns:
+//   - The crossings in chronological order, in the time zone of start (nil if there are none)
+//
+// Example:
+//
+//	// Every sunrise and sunset in Tromsø during the week the midnight sun begins
+//	loc := solar.NewLocation(69.65, 18.96)
+//	start := time.Date(2024, time.May, 15, 0, 0, 0, 0, time.UTC)
+//	for _, c := range solar.ElevationCrossings(loc, -0.833, start, start.AddDate(0, 0, 7)) {
+//	    fmt.Println(c.Time, c.Direction)
+//	}
+func ElevationCrossings(loc Location, elevation float64, start, end time.Time, algorithm ...Algorithm) []Crossing {
+	var (
+		alg  = selectAlgorithm(loc, algorithm)
+		jd0  = TimeToJulianDay(start)
+		jd1  = TimeToJulianDay(end)
+		zone = start.Location()
+		f    = func(jd float64) float64 {
+			return instantElevation(alg, loc, jd) - elevation
+		}
+	)
+	if jd1 <= jd0 {
+		return nil
+	}
+
+	var roots []float64
+	addRoots := func(a, fa, b, fb float64) {
+		if fa == 0 {
+			roots = append(roots, a)
+		} else if fa*fb < 0 {
+			roots = append(roots, bisectRoot(f, a, fa, b))
+		}
+	}
+
+	// Sample the elevation, find the roots in every interval where it changes
+	// sign, and look between samples for an extremum that crosses the
+	// elevation and back
+	var (
+		x0, f0 = jd0, f(jd0)
+		x1     = math.Min(jd0+crossingStep, jd1)
+		f1     = f(x1)
+	)
+	addRoots(x0, f0, x1, f1)
+	for x1 < jd1 {
+		x2 := math.Min(x1+crossingStep, jd1)
+		f2 := f(x2)
+
+		if (f1-f0)*(f2-f1) < 0 && f0*f1 > 0 && f1*f2 > 0 {
+			xe := extremum(f, x0, x2, f1 > f0)
+			if fe := f(xe); fe*f1 < 0 {
+				roots = append(roots, bisectRoot(f, x0, f0, xe), bisectRoot(f, xe, fe, x2))
+			}
+		}
+		addRoots(x1, f1, x2, f2)
+
+		x0, f0 = x1, f1
+		x1, f1 = x2, f2
+	}
+
+	if len(roots) == 0 {
+		return nil
+	}
+	sort.Float64s(roots)
+
+	crossings := make([]Crossing, 0, len(roots))
+	for _, jd := range roots {
+		if jd < jd0 || jd >= jd1 {
+			continue
+		}
+		direction := Rising
+		if f(jd+crossingTolerance*100) < f(jd-crossingTolerance*100) {
+			direction = Setting
+		}
+		crossings = append(crossings, Crossing{
+			Time:      JulianDayToTime(jd).In(zone),
+			Direction: direction,
+		})
+	}
+	return crossings
+}
+
+// bisectRoot locates the root of f between a and b, where f changes sign, to
+// within crossingTolerance. fa is the value of f at a.
+func bisectRoot(f func(float64) float64, a, fa, b float64) float64 {
+	for b-a > crossingTolerance {
+		m := (a + b) / 2
+		fm := f(m)
+		if fm == 0 {
+			return m
+		}
+		if (fm < 0) == (fa < 0) {
+			a, fa = m, fm
+		} else {
+			b = m
+		}
+	}
+	return (a + b) / 2
+}
+
+// extremum returns the location of the maximum (or minimum) of f between a and
+// b by golden-section search.
+func extremum(f func(float64) float64, a, b float64, maximum bool) float64 {
+	const invPhi = 0.6180339887498949
+
+	sign := 1.0
+	if !maximum {
+		sign = -1
+	}
+
+	var (
+		c  = b - invPhi*(b-a)
+		d  = a + invPhi*(b-a)
+		fc = sign * f(c)
+		fd = sign * f(d)
+	)
+	for b-a > crossingTolerance {
+		if fc > fd {
+			b, d, fd = d, c, fc
+			c = b - invPhi*(b-a)
+			fc = sign * f(c)
+		} else {
+			a, c, fc = c, d, fd
+			d = a + invPhi*(b-a)
+			fd = sign * f(d)
+		}
+	}
+	return (a + b) / 2
+}
@@ -0,0 +1,150 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestElevationCrossings_Day checks an ordinary day against refined sunrise
+// and sunset, which locate the same crossings.
+func TestElevationCrossings_Day(t *testing.T) {
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			loc := NewLocation(43.65, -79.38).WithAlgorithm(algorithm)
+			zone := time.FixedZone("EST", -5*60*60)
+			tm := NewTimeIn(2024, time.February, 1, zone)
+			horizon := loc.horizon() / Degree
+
+			start := tm.DateTime()
+			crossings := ElevationCrossings(loc, horizon, start, start.AddDate(0, 0, 1))
+			if len(crossings) != 2 {
+				t.Fatalf("ElevationCrossings() = %v, want 2 crossings", crossings)
+			}
+			if crossings[0].Direction != Rising || crossings[1].Direction != Setting {
+				t.Errorf("directions = %s, %s, want Rising, Setting", crossings[0].Direction, crossings[1].Direction)
+			}
+
+			sunrise, sunset, err := SunriseSunset(loc.WithRefinement(true), tm)
+			if err != nil {
+				t.Fatalf("SunriseSunset() error = %v", err)
+			}
+			for i, want := range []time.Time{sunrise, sunset} {
+				got := crossings[i].Time
+				if d := got.Sub(want); d < -time.Millisecond || d > time.Millisecond {
+					t.Errorf("crossing %d = %s, want %s", i, got, want)
+				}
+				if got.Location() != zone {
+					t.Errorf("crossing %d location = %v, want %v", i, got.Location(), zone)
+				}
+			}
+		})
+	}
+}
+
+// TestElevationCrossings_Graze checks the two close crossings when the sun
+// dips only just below the elevation around local midnight, which daily
+// formulas treat as either no crossing or a symmetric pair around noon.
+func TestElevationCrossings_Graze(t *testing.T) {
+	loc := NewLocation(69.65, 18.96).WithAlgorithm(NOAA)
+	start := time.Date(2024, time.May, 20, 12, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	// Find the lowest elevation of the night on a fine grid
+	lowest := math.MaxFloat64
+	var lowestTime time.Time
+	for when := start; when.Before(end); when = when.Add(10 * time.Second) {
+		if e := Elevation(loc, when); e < lowest {
+			lowest, lowestTime = e, when
+		}
+	}
+
+	// The sun spends only a few minutes below an elevation 0.002° above its
+	// lowest point, less than the sampling interval
+	elevation := lowest + 0.002
+	crossings := ElevationCrossings(loc, elevation, start, end)
+	if len(crossings) != 2 {
+		t.Fatalf("ElevationCrossings() = %v, want 2 crossings", crossings)
+	}
+	if crossings[0].Direction != Setting || crossings[1].Direction != Rising {
+		t.Errorf("directions = %s, %s, want Setting, Rising", crossings[0].Direction, crossings[1].Direction)
+	}
+	if gap := crossings[1].Time.Sub(crossings[0].Time); gap > 10*time.Minute {
+		t.Errorf("crossings are %s apart, want less than 10 minutes", gap)
+	}
+	if crossings[0].Time.After(lowestTime) || crossings[1].Time.Before(lowestTime) {
+		t.Errorf("crossings %s and %s do not surround the lowest point %s", crossings[0].Time, crossings[1].Time, lowestTime)
+	}
+	for _, c := range crossings {
+		if e := Elevation(loc, c.Time); math.Abs(e-elevation) > 1e-6 {
+			t.Errorf("elevation at %s = %.7f, want %.7f", c.Time, e, elevation)
+		}
+	}
+
+	// Just below the lowest point there is nothing to cross
+	if crossings := ElevationCrossings(loc, lowest-0.002, start, end); len(crossings) != 0 {
+		t.Errorf("ElevationCrossings(below) = %v, want none", crossings)
+	}
+}
+
+// TestElevationCrossings_Window checks a multi-day window at a polar site
+// as the midnight sun begins.
+func TestElevationCrossings_Window(t *testing.T) {
+	loc := NewLocation(69.65, 18.96).WithAlgorithm(SPA)
+	start := time.Date(2024, time.May, 10, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+
+	crossings := ElevationCrossings(loc, loc.horizon()/Degree, start, end)
+	// The window starts at night and ends in the midnight sun, so the sun
+	// rises once more than it sets
+	if len(crossings)%2 != 1 {
+		t.Fatalf("ElevationCrossings() = %d crossings, want an odd number", len(crossings))
+	}
+	if first, last := crossings[0], crossings[len(crossings)-1]; first.Direction != Rising || last.Direction != Rising {
+		t.Errorf("first and last crossings are %s and %s, want Rising", first.Direction, last.Direction)
+	}
+	for i := 1; i < len(crossings); i++ {
+		if crossings[i].Direction == crossings[i-1].Direction {
+			t.Errorf("crossings %d and %d are both %s", i-1, i, crossings[i].Direction)
+		}
+		if !crossings[i].Time.After(crossings[i-1].Time) {
+			t.Errorf("crossings %d and %d are out of order", i-1, i)
+		}
+	}
+
+	// The sun no longer sets in the last days of the window
+	last := crossings[len(crossings)-1]
+	if last.Time.After(end.AddDate(0, 0, -2)) {
+		t.Errorf("last crossing %s, want the midnight sun before %s", last.Time, end.AddDate(0, 0, -2))
+	}
+}
+
+func TestElevationCrossings_None(t *testing.T) {
+	loc := NewLocation(69.3321443, -81.6781126)
+	start := time.Date(2020, time.June, 25, 0, 0, 0, 0, time.UTC)
+
+	if crossings := ElevationCrossings(loc, 0, start, start.AddDate(0, 0, 1)); crossings != nil {
+		t.Errorf("midnight sun crossings = %v, want none", crossings)
+	}
+	if crossings := ElevationCrossings(loc, 0, start, start); crossings != nil {
+		t.Errorf("empty window crossings = %v, want none", crossings)
+	}
+}
+
+func TestCrossingDirection_String(t *testing.T) {
+	if Rising.String() != "Rising" || Setting.String() != "Setting" {
+		t.Errorf("String() = %q, %q, want Rising, Setting", Rising, Setting)
+	}
+}
+
+// BenchmarkElevationCrossings benchmarks a one-day search
+func BenchmarkElevationCrossings(b *testing.B) {
+	loc := NewLocation(40.7128, -74.0060)
+	start := time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = ElevationCrossings(loc, -0.833, start, end)
+	}
+}
@@ -0,0 +1,44 @@
+package solar
+
+import "time"
+
+// dateEvent computes an event for a UTC date.
+type dateEvent func(year int, month time.Month, day int) (time.Time, error)
+
+// onDay computes an event for the day of t.
+//
+// For a UTC date the event is computed for that date, as it always has been,
+// and may fall slightly outside the UTC day. For a Time with a time zone the
+// event is computed for the UTC dates around the local civil day, and the
+// earliest one within that day is returned in the zone. If none falls within
+// the day, the error for the UTC date of local noon is returned, or
+// ErrNoEventOnDay if there was none.
+func onDay(t Time, event dateEvent) (time.Time, error) {
+	if t.zone == nil {
+		return event(t.Year(), t.Month(), t.Day())
+	}
+
+	var (
+		start  = t.when
+		end    = start.AddDate(0, 0, 1)
+		noon   = start.Add(end.Sub(start) / 2).UTC()
+		dayErr = ErrNoEventOnDay
+	)
+
+	// An event computed for a UTC date lies within about a day and a half of
+	// it, so two dates either side of local noon cover the whole local day
+	for offset := -2; offset <= 2; offset++ {
+		date := noon.AddDate(0, 0, offset)
+		when, err := event(date.Year(), date.Month(), date.Day())
+		if err != nil {
+			if offset == 0 {
+				dayErr = err
+			}
+			continue
+		}
+		if !when.Before(start) && when.Before(end) {
+			return when.In(t.zone), nil
+		}
+	}
+	return time.Time{}, dayErr
+}
@@ -0,0 +1,169 @@
+package solar
+
+import (
+	"testing"
+	"time"
+)
+
+// dataLocalDay holds locations whose time zone is far from their solar time,
+// where the UTC date and the local date of an event often differ.
+var dataLocalDay = []struct {
+	name     string
+	location Location
+	zone     *time.Location
+}{
+	{
+		// Line Islands, UTC+14 at 157° W
+		name:     "Kiritimati",
+		location: NewLocation(1.87, -157.4),
+		zone:     time.FixedZone("LINT", 14*60*60),
+	},
+	{
+		// American Samoa, UTC-11 at 170° W
+		name:     "Pago Pago",
+		location: NewLocation(-14.28, -170.7),
+		zone:     time.FixedZone("SST", -11*60*60),
+	},
+	{
+		// Chatham Islands, UTC+13:45 at 176° W
+		name:     "Chatham Islands",
+		location: NewLocation(-43.95, -176.56),
+		zone:     time.FixedZone("CHADT", 13*60*60+45*60),
+	},
+	{
+		name:     "Boulder in UTC",
+		location: NewLocation(40, -105),
+		zone:     time.UTC,
+	},
+}
+
+// TestLocalDay checks that every event of a zoned Time falls within the local
+// civil day and is returned in its time zone.
+func TestLocalDay(t *testing.T) {
+	for _, tt := range dataLocalDay {
+		for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+			t.Run(tt.name+"/"+algorithm.String(), func(t *testing.T) {
+				loc := tt.location.WithAlgorithm(algorithm)
+
+				for day := 1; day <= 365; day += 7 {
+					tm := NewTimeIn(2024, time.January, day, tt.zone)
+					start := tm.DateTime()
+					end := start.AddDate(0, 0, 1)
+
+					sunrise, sunset, err := SunriseSunset(loc, tm)
+					if err != nil {
+						t.Fatalf("%s: SunriseSunset() error = %v", tm, err)
+					}
+					dawn, dusk := DawnDusk(loc, tm)
+					morning, evening := TimeOfElevation(loc, 10, tm)
+
+					events := map[string]time.Time{
+						"sunrise": sunrise,
+						"sunset":  sunset,
+						"dawn":    dawn,
+						"dusk":    dusk,
+						"morning": morning,
+						"evening": evening,
+						"noon":    MeanSolarNoon(loc, tm),
+					}
+					for name, when := range events {
+						if when.Before(start) || !when.Before(end) {
+							t.Errorf("%s: %s = %s, outside the local day", tm, name, when)
+						}
+						if when.Location() != tt.zone {
+							t.Errorf("%s: %s location = %v, want %v", tm, name, when.Location(), tt.zone)
+						}
+					}
+
+					if rise, _ := Sunrise(loc, tm); !rise.Equal(sunrise) {
+						t.Errorf("%s: Sunrise() = %s, SunriseSunset() = %s", tm, rise, sunrise)
+					}
+					if d := Dusk(loc, tm); !d.Equal(dusk) {
+						t.Errorf("%s: Dusk() = %s, DawnDusk() = %s", tm, d, dusk)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestLocalDay_UTCDate shows the problem a zoned Time solves: for a UTC date
+// the sunrise at Kiritimati falls on the next local day.
+func TestLocalDay_UTCDate(t *testing.T) {
+	tt := dataLocalDay[0]
+
+	utc, err := Sunrise(tt.location, NewTime(2024, time.January, 15))
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	if got := utc.In(tt.zone).Day(); got != 16 {
+		t.Fatalf("UTC-date sunrise is on local day %d, want 16", got)
+	}
+
+	local, err := Sunrise(tt.location, NewTimeIn(2024, time.January, 15, tt.zone))
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	if local.Day() != 15 {
+		t.Errorf("local sunrise = %s, want January 15", local)
+	}
+	if d := utc.Sub(local); d < 23*time.Hour || d > 25*time.Hour {
+		t.Errorf("local sunrise %s should be a day before %s", local, utc)
+	}
+}
+
+func TestLocalDay_Polar(t *testing.T) {
+	loc := NewLocation(69.3321443, -81.6781126)
+	zone := time.FixedZone("EST", -5*60*60)
+
+	_, _, err := SunriseSunset(loc, NewTimeIn(2020, time.June, 25, zone))
+	if err != ErrSunNeverSets {
+		t.Errorf("midnight sun error = %v, want %v", err, ErrSunNeverSets)
+	}
+	_, err = Sunrise(loc, NewTimeIn(2020, time.December, 21, zone))
+	if err != ErrSunNeverRises {
+		t.Errorf("polar night error = %v, want %v", err, ErrSunNeverRises)
+	}
+
+	dawn, dusk := DawnDusk(NewLocation(51.5072, -0.1276), NewTimeIn(2022, time.June, 21, time.UTC), Astronomical)
+	if !dawn.IsZero() || !dusk.IsZero() {
+		t.Errorf("DawnDusk(Astronomical) = %s, %s, want zero times", dawn, dusk)
+	}
+}
+
+// TestLocalDay_DaylightSaving checks the 23 and 25 hour days at the daylight
+// saving time transitions.
+func TestLocalDay_DaylightSaving(t *testing.T) {
+	zone, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+	loc := NewLocation(40.7128, -74.0060)
+
+	for _, tm := range []Time{
+		NewTimeIn(2024, time.March, 10, zone),
+		NewTimeIn(2024, time.November, 3, zone),
+	} {
+		sunrise, sunset, err := SunriseSunset(loc, tm)
+		if err != nil {
+			t.Fatalf("%s: SunriseSunset() error = %v", tm, err)
+		}
+		if sunrise.Day() != tm.Day() || sunset.Day() != tm.Day() {
+			t.Errorf("%s: sunrise %s, sunset %s not on the local day", tm, sunrise, sunset)
+		}
+		if h := sunrise.Hour(); h < 6 || h > 7 {
+			t.Errorf("%s: sunrise %s, want between 06:00 and 08:00", tm, sunrise)
+		}
+	}
+}
+
+// BenchmarkSunriseSunset_LocalDay benchmarks sunrise/sunset for a zoned Time
+func BenchmarkSunriseSunset_LocalDay(b *testing.B) {
+	loc := NewLocation(40.7128, -74.0060)
+	tm := NewTimeIn(2024, time.June, 21, time.FixedZone("EDT", -4*60*60))
+
+	b.ResetTimer()
+	for b.Loop() {
+		_, _, _ = SunriseSunset(loc, tm)
+	}
+}
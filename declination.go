@@ -10,3 +10,16 @@ import (
 func declination(eclipticLongitude float64) float64 {
 	return math.Asin(math.Sin(eclipticLongitude*Degree)*SinDeclinationCoefficient) / Degree
 }
+
+// declinationAt calculates the declination for the given ecliptic longitude
+// and obliquity of the ecliptic, both in degrees.
+func declinationAt(eclipticLongitude, obliquity float64) float64 {
+	return math.Asin(math.Sin(eclipticLongitude*Degree)*math.Sin(obliquity*Degree)) / Degree
+}
+
+// meanObliquity calculates the mean obliquity of the ecliptic, in degrees, for
+// the given number of Julian centuries since J2000, with the polynomial of
+// Laskar that is valid over ten thousand years.
+func meanObliquity(t float64) float64 {
+	return spaMeanObliquity(t/10) / 3600
+}
@@ -1,6 +1,7 @@
 package solar
 
 import (
+	"math"
 	"testing"
 )
 
@@ -24,3 +25,30 @@ func TestDeclination(t *testing.T) {
 		}
 	}
 }
+
+func TestDeclinationAt(t *testing.T) {
+	obliquity := math.Asin(SinDeclinationCoefficient) / Degree
+	for _, tt := range dataDeclination {
+		if v, want := declinationAt(tt.in, obliquity), declination(tt.in); !AlmostEqual(v, want, 1e-9) {
+			t.Errorf("declinationAt(%f) = %f, want %f", tt.in, v, want)
+		}
+	}
+}
+
+func TestMeanObliquity(t *testing.T) {
+	tests := []struct {
+		century   float64
+		want      float64
+		tolerance float64
+	}{
+		{0, 23.4392911, 1e-7},               // 23°26'21.448" at J2000
+		{-0.127296372348, 23.4409464, 1e-6}, // Meeus example 22.a, 23°26'27.407"
+		{-30, 23.81, 0.01},                  // about 1000 BC
+		{10, 23.31, 0.01},                   // about 3000 AD
+	}
+	for _, tt := range tests {
+		if v := meanObliquity(tt.century); !AlmostEqual(v, tt.want, tt.tolerance) {
+			t.Errorf("meanObliquity(%f) = %.7f, want %.7f", tt.century, v, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// deltaT estimates ΔT = TT − UT, in seconds, for the given decimal year using
+// the polynomials of Espenak and Meeus (NASA Five Millennium Canon of Solar
+// Eclipses) from -500 to 2150, and the long-term parabola of Morrison and
+// Stephenson elsewhere.
+func deltaT(year float64) float64 {
+	switch {
+	case year < -500:
+		return deltaTParabola(year)
+	case year < 500:
+		u := year / 100
+		return polynomial(u, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case year < 1600:
+		u := (year - 1000) / 100
+		return polynomial(u, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case year < 1700:
+		return polynomial(year-1600, 120, -0.9808, -0.01532, 1.0/7129)
+	case year < 1800:
+		return polynomial(year-1700, 8.83, 0.1603, -0.0059285, 0.00013336, -1.0/1174000)
+	case year < 1860:
+		return polynomial(year-1800, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436,
+			0.0000121272, -0.0000001699, 0.000000000875)
+	case year < 1900:
+		return polynomial(year-1860, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1.0/233174)
+	case year < 1920:
+		return polynomial(year-1900, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case year < 1941:
+		return polynomial(year-1920, 21.20, 0.84493, -0.076100, 0.0020936)
+	case year < 1961:
+		return polynomial(year-1950, 29.07, 0.407, -1.0/233, 1.0/2547)
+	case year < 1986:
+		return polynomial(year-1975, 45.45, 1.067, -1.0/260, -1.0/718)
+	case year < 2005:
+		return polynomial(year-2000, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case year < 2050:
+		return polynomial(year-2000, 62.92, 0.32217, 0.005589)
+	case year < 2150:
+		return deltaTParabola(year) - 0.5628*(2150-year)
+	default:
+		return deltaTParabola(year)
+	}
+}
+
+// deltaTParabola is the long-term ΔT parabola of Morrison and Stephenson, in
+// seconds, for the given decimal year.
+func deltaTParabola(year float64) float64 {
+	u := (year - 1820) / 100
+	return -20 + 32*u*u
+}
+
+// polynomial evaluates c[0] + c[1]·x + c[2]·x² + … by Horner's method.
+func polynomial(x float64, c ...float64) float64 {
+	var v float64
+	for i := len(c) - 1; i >= 0; i-- {
+		v = v*x + c[i]
+	}
+	return v
+}
+
+// julianDayToDecimalYear converts a Julian day into a decimal year suitable
+// for deltaT.
+func julianDayToDecimalYear(jd float64) float64 {
+	return 2000 + (jd-J2000)/365.25
+}
+
+// DeltaT returns ΔT, the difference TT − UT1 between Terrestrial Time and
+// Universal Time at the given instant.
+//
+// ΔT grows irregularly as the Earth's rotation slows: it was about two minutes
+// in 1600, close to zero around 1900 and a little over a minute today. It is
+// estimated with the polynomials of Espenak and Meeus, which follow the
+// historical record from -500 to the present and extrapolate it to 2150, and
+// with the long-term parabola of Morrison and Stephenson beyond that range.
+// Estimates for the distant past and future are uncertain by minutes to hours.
+//
+// Parameters:
+//   - when: The instant at which to estimate ΔT
+//
+// Returns:
+//   - ΔT as a duration (TT − UT1)
+//
+// Example:
+//
+//	dt := solar.DeltaT(time.Date(1600, time.January, 1, 0, 0, 0, 0, time.UTC))
+//	// dt is about 2 minutes
+func DeltaT(when time.Time) time.Duration {
+	seconds := deltaT(julianDayToDecimalYear(TimeToJulianDay(when)))
+	return time.Duration(math.Round(seconds * float64(time.Second)))
+}
@@ -0,0 +1,87 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDeltaT(t *testing.T) {
+	tests := []struct {
+		name      string
+		year      float64
+		want      float64
+		tolerance float64
+	}{
+		{"2000", 2000, 63.86, 0.01},
+		{"2003.8", 2003.8, 64.6, 0.5},
+		{"2020", 2020, 71.6, 0.5},
+		{"2100", 2100, 202.7, 1.0},
+		// Espenak–Meeus values, close to the historical record
+		{"-500", -500, 17190, 20},
+		{"0", 0, 10583.6, 0.1},
+		{"1000", 1000, 1574.2, 0.1},
+		{"1600", 1600, 120, 0.01},
+		{"1700", 1700, 8.83, 0.01},
+		{"1820", 1820, 12, 0.5},
+		{"1900", 1900, -2.79, 0.01},
+		{"1950", 1950, 29.07, 0.01},
+		{"1975", 1975, 45.45, 0.01},
+		// Long-term parabola
+		{"-1000", -1000, 25427.7, 0.1},
+		{"2500", 2500, 1459.7, 0.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if v := deltaT(tt.year); !AlmostEqual(v, tt.want, tt.tolerance) {
+				t.Errorf("deltaT(%.1f) = %.2f, want %.2f (±%.2f)", tt.year, v, tt.want, tt.tolerance)
+			}
+		})
+	}
+}
+
+func TestJulianDayToDecimalYear(t *testing.T) {
+	if v := julianDayToDecimalYear(J2000); v != 2000 {
+		t.Errorf("julianDayToDecimalYear(J2000) = %f, want 2000", v)
+	}
+}
+
+// TestDeltaT_Continuous checks that the polynomials join without large jumps.
+func TestDeltaT_Continuous(t *testing.T) {
+	for _, year := range []float64{-500, 500, 1600, 1700, 1800, 1860, 1900, 1920, 1941, 1961, 1986, 2005, 2050, 2150} {
+		if d := deltaT(year) - deltaT(year-1e-9); d < -5 || d > 5 {
+			t.Errorf("deltaT jumps by %.2f s at %.0f", d, year)
+		}
+	}
+}
+
+func TestDeltaTPublic(t *testing.T) {
+	when := time.Date(1600, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if dt := DeltaT(when); dt < 119*time.Second || dt > 121*time.Second {
+		t.Errorf("DeltaT(1600) = %s, want about 2m", dt)
+	}
+	when = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+	if dt := DeltaT(when); dt != time.Duration(math.Round(deltaT(2000)*1e9)) {
+		t.Errorf("DeltaT(J2000) = %s, want %.3fs", dt, deltaT(2000))
+	}
+}
+
+func TestPolynomial(t *testing.T) {
+	if v := polynomial(2, 1, 2, 3); v != 17 {
+		t.Errorf("polynomial(2, 1, 2, 3) = %f, want 17", v)
+	}
+	if v := polynomial(2); v != 0 {
+		t.Errorf("polynomial(2) = %f, want 0", v)
+	}
+}
+
+// BenchmarkDeltaT benchmarks the delta-T estimate
+func BenchmarkDeltaT(b *testing.B) {
+	when := time.Date(1750, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = DeltaT(when)
+	}
+}
@@ -0,0 +1,108 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// SolarConstant is the mean total solar irradiance at one astronomical
+	// unit from the sun, in watts per square meter (Kopp and Lean, 2011).
+	SolarConstant = 1361.0
+
+	// sunRadiusAtOneAU is the apparent radius of the sun seen from one
+	// astronomical unit (959.63 arc seconds, in degrees).
+	sunRadiusAtOneAU = 959.63 / 3600
+)
+
+// earthSunDistance returns the distance between the Earth and the sun, in
+// astronomical units, at the Julian day jd using the given algorithm.
+func earthSunDistance(algorithm Algorithm, jd float64) float64 {
+	switch algorithm {
+	case SPA:
+		jde := jd + deltaT(julianDayToDecimalYear(jd))/secondsInADay
+		return spaEarthValue(spaRTerms[:], (jde-J2000)/JulianCenturyDays/10)
+	case NOAA:
+		return noaaPosition(jd).radiusVector
+	default:
+		return sunriseEquationDistance(meanAnomaly(jd))
+	}
+}
+
+// sunriseEquationDistance returns the low-precision radius vector of the
+// Astronomical Almanac, in astronomical units, for the given mean anomaly.
+func sunriseEquationDistance(meanAnomaly float64) float64 {
+	anomalyRad := meanAnomaly * Degree
+	return 1.00014 - 0.01671*math.Cos(anomalyRad) - 0.00014*math.Cos(2*anomalyRad)
+}
+
+// angularRadius returns the apparent radius of the sun, in degrees, at the
+// given distance in astronomical units.
+func angularRadius(distance float64) float64 {
+	return sunRadiusAtOneAU / distance
+}
+
+// irradiance returns the solar irradiance at the top of the atmosphere, in
+// watts per square meter, at the given distance in astronomical units.
+func irradiance(distance float64) float64 {
+	return SolarConstant / (distance * distance)
+}
+
+// EarthSunDistance calculates the distance between the Earth and the sun at
+// the given instant, in astronomical units. It ranges from about 0.983 AU at
+// perihelion in early January to about 1.017 AU at aphelion in early July.
+//
+// Parameters:
+//   - when: The moment at which to calculate the distance
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to SunriseEquation.
+//
+// Returns:
+//   - The radius vector in astronomical units
+//
+// Example:
+//
+//	r := solar.EarthSunDistance(time.Date(2025, time.January, 4, 13, 0, 0, 0, time.UTC), solar.SPA)
+//	// r is about 0.9833 AU
+func EarthSunDistance(when time.Time, algorithm ...Algorithm) float64 {
+	return earthSunDistance(optionalAlgorithm(algorithm), TimeToJulianDay(when))
+}
+
+// SunAngularRadius calculates the apparent angular radius (semidiameter) of
+// the sun's disk at the given instant, in degrees. It varies between about
+// 15.73 and 16.27 arc minutes over the year, around the mean of
+// SolarSemidiameter.
+//
+// Parameters:
+//   - when: The moment at which to calculate the radius
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to SunriseEquation.
+//
+// Returns:
+//   - The apparent radius of the sun in degrees
+//
+// Example:
+//
+//	radius := solar.SunAngularRadius(time.Date(2025, time.January, 4, 13, 0, 0, 0, time.UTC))
+//	fmt.Printf("%.2f'\n", radius*60) // 16.27'
+func SunAngularRadius(when time.Time, algorithm ...Algorithm) float64 {
+	return angularRadius(EarthSunDistance(when, algorithm...))
+}
+
+// ExtraterrestrialIrradiance calculates the solar irradiance at the top of the
+// atmosphere, on a surface facing the sun, at the given instant: the
+// SolarConstant scaled by the inverse square of the Earth–sun distance. It
+// ranges from about 1316 W/m² in July to about 1408 W/m² in January.
+//
+// Parameters:
+//   - when: The moment at which to calculate the irradiance
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to SunriseEquation.
+//
+// Returns:
+//   - The extraterrestrial irradiance in watts per square meter
+//
+// Example:
+//
+//	irradiance := solar.ExtraterrestrialIrradiance(time.Date(2025, time.July, 3, 20, 0, 0, 0, time.UTC))
+//	// irradiance is about 1316 W/m²
+func ExtraterrestrialIrradiance(when time.Time, algorithm ...Algorithm) float64 {
+	return irradiance(EarthSunDistance(when, algorithm...))
+}
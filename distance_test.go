@@ -0,0 +1,130 @@
+package solar
+
+import (
+	"testing"
+	"time"
+)
+
+// dataDistance holds the Earth–sun distances at the apsides of 2025 published
+// by the U.S. Naval Observatory.
+var dataDistance = []struct {
+	name     string
+	when     time.Time
+	distance float64
+}{
+	{"perihelion", time.Date(2025, time.January, 4, 13, 28, 0, 0, time.UTC), 0.983324},
+	{"aphelion", time.Date(2025, time.July, 3, 19, 55, 0, 0, time.UTC), 1.016644},
+}
+
+func TestEarthSunDistance(t *testing.T) {
+	tolerances := map[Algorithm]float64{SunriseEquation: 0.0002, NOAA: 0.0001, SPA: 0.000005}
+	for _, tt := range dataDistance {
+		for algorithm, tolerance := range tolerances {
+			t.Run(tt.name+"/"+algorithm.String(), func(t *testing.T) {
+				if r := EarthSunDistance(tt.when, algorithm); !AlmostEqual(r, tt.distance, tolerance) {
+					t.Errorf("EarthSunDistance() = %.6f, want %.6f (±%g)", r, tt.distance, tolerance)
+				}
+			})
+		}
+	}
+
+	// The default is the sunrise equation
+	when := dataDistance[0].when
+	if r, want := EarthSunDistance(when), EarthSunDistance(when, SunriseEquation); r != want {
+		t.Errorf("EarthSunDistance() = %f, want %f", r, want)
+	}
+}
+
+func TestSunAngularRadius(t *testing.T) {
+	tests := []struct {
+		when    time.Time
+		minutes float64
+	}{
+		{dataDistance[0].when, 16.265},
+		{dataDistance[1].when, 15.732},
+	}
+	for _, tt := range tests {
+		if r := SunAngularRadius(tt.when, SPA) * 60; !AlmostEqual(r, tt.minutes, 0.001) {
+			t.Errorf("SunAngularRadius(%s) = %.3f', want %.3f'", tt.when, r, tt.minutes)
+		}
+	}
+}
+
+func TestExtraterrestrialIrradiance(t *testing.T) {
+	tests := []struct {
+		when       time.Time
+		irradiance float64
+	}{
+		{dataDistance[0].when, 1407.5},
+		{dataDistance[1].when, 1316.8},
+	}
+	for _, tt := range tests {
+		if e := ExtraterrestrialIrradiance(tt.when, SPA); !AlmostEqual(e, tt.irradiance, 0.1) {
+			t.Errorf("ExtraterrestrialIrradiance(%s) = %.1f, want %.1f", tt.when, e, tt.irradiance)
+		}
+	}
+	if e := irradiance(1); e != SolarConstant {
+		t.Errorf("irradiance(1) = %f, want %f", e, SolarConstant)
+	}
+}
+
+func TestPosition_Distance(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	when := dataDistance[0].when
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		pos := Position(loc, when, algorithm)
+		if !AlmostEqual(pos.Distance, EarthSunDistance(when, algorithm), 1e-4) {
+			t.Errorf("%s: Distance = %f, want %f", algorithm, pos.Distance, EarthSunDistance(when, algorithm))
+		}
+		if pos.AngularRadius != angularRadius(pos.Distance) || pos.Irradiance != irradiance(pos.Distance) {
+			t.Errorf("%s: AngularRadius, Irradiance = %f, %f, inconsistent with the distance", algorithm, pos.AngularRadius, pos.Irradiance)
+		}
+	}
+}
+
+// TestVaryingSemidiameter checks that the larger sun of January rises earlier
+// and sets later, and the smaller sun of July the opposite, by a few seconds.
+func TestVaryingSemidiameter(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	if loc.VaryingSemidiameter() {
+		t.Error("VaryingSemidiameter() = true by default")
+	}
+	varying := loc.WithVaryingSemidiameter(true)
+	if !varying.VaryingSemidiameter() {
+		t.Error("WithVaryingSemidiameter(true).VaryingSemidiameter() = false")
+	}
+
+	tests := []struct {
+		date  Time
+		later bool
+	}{
+		{NewTime(2025, time.January, 4), false},
+		{NewTime(2025, time.July, 3), true},
+	}
+	for _, tt := range tests {
+		for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+			t.Run(tt.date.String()+"/"+algorithm.String(), func(t *testing.T) {
+				sunrise, sunset, _ := SunriseSunset(loc, tt.date, algorithm)
+				vSunrise, vSunset, _ := SunriseSunset(varying, tt.date, algorithm)
+
+				shift := vSunrise.Sub(sunrise)
+				if tt.later == (shift < 0) || shift.Abs() < time.Second || shift.Abs() > 5*time.Second {
+					t.Errorf("sunrise moved by %s", shift)
+				}
+				if d := vSunset.Sub(sunset); (d + shift).Abs() > 100*time.Millisecond {
+					t.Errorf("sunset moved by %s, want about %s", d, -shift)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkEarthSunDistance benchmarks the Earth–sun distance
+func BenchmarkEarthSunDistance(b *testing.B) {
+	when := dataDistance[0].when
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = EarthSunDistance(when)
+	}
+}
@@ -1,43 +1,115 @@
 package solar
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"time"
 )
 
-// timeOfElevationInternal is the internal implementation with old signature
-func timeOfElevationInternal(latitude, longitude, elevation float64, year int, month time.Month, day int) (morning, evening time.Time) {
+// ElevationError is returned by the event functions when the sun does not
+// reach an elevation on a given day because it stays above it (a white night
+// for a twilight angle) or below it (a polar night) all day.
+//
+// It matches ErrSunNeverSets with errors.Is when the sun stays above the
+// elevation, and ErrSunNeverRises when it stays below, so the same checks work
+// for sunrise, sunset, twilight and any other elevation. Use errors.As to
+// obtain the elevation:
+//
+//	_, err := solar.DawnErr(loc, t, solar.Astronomical)
+//	var elevErr *solar.ElevationError
+//	if errors.As(err, &elevErr) && elevErr.Above {
+//	    // The sun never sinks to -18°: no astronomical night
+//	}
+type ElevationError struct {
+	// Elevation is the elevation, in degrees, that the sun does not reach.
+	Elevation float64
+
+	// Above is true if the sun stays above the elevation all day, and false
+	// if it stays below.
+	Above bool
+}
+
+// Error returns a description of the error, with the elevation rounded to a
+// thousandth of a degree.
+func (e *ElevationError) Error() string {
+	elevation := math.Round(e.Elevation*1000) / 1000
+	if e.Above {
+		return fmt.Sprintf("sun stays above %g° all day", elevation)
+	}
+	return fmt.Sprintf("sun stays below %g° all day", elevation)
+}
+
+// Unwrap returns ErrSunNeverSets if the sun stays above the elevation and
+// ErrSunNeverRises if it stays below.
+func (e *ElevationError) Unwrap() error {
+	if e.Above {
+		return ErrSunNeverSets
+	}
+	return ErrSunNeverRises
+}
+
+// elevationError turns ErrSunNeverRises and ErrSunNeverSets, returned when the
+// sun does not reach the given elevation, into an ElevationError. Other errors
+// are returned unchanged.
+func elevationError(elevation float64, err error) error {
+	switch {
+	case errors.Is(err, ErrSunNeverRises):
+		return &ElevationError{Elevation: elevation}
+	case errors.Is(err, ErrSunNeverSets):
+		return &ElevationError{Elevation: elevation, Above: true}
+	default:
+		return err
+	}
+}
+
+// timeOfElevationInternal is the internal implementation with old signature.
+// It returns ErrSunNeverRises if the sun stays below the elevation all day and
+// ErrSunNeverSets if it stays above.
+func timeOfElevationInternal(latitude, longitude, elevation float64, secular bool, year int, month time.Month, day int) (morning, evening time.Time, err error) {
+	d := meanSolarNoonInternal(longitude, year, month, day)
+	return sunriseEquationElevationTimes(latitude, elevation, sunriseEquation(d, secular))
+}
+
+// sunriseEquationElevationTimes returns the times at which the sun reaches the
+// elevation around the transit of the sunrise equation result sun, which holds
+// the declination for the whole day.
+func sunriseEquationElevationTimes(latitude, elevation float64, sun sunriseEquationResult) (morning, evening time.Time, err error) {
 	var (
-		d                 = meanSolarNoonInternal(longitude, year, month, day)
-		meanAnomaly       = meanAnomaly(d)
-		equationOfCenter  = equationOfCenter(meanAnomaly)
-		eclipticLongitude = eclipticLongitude(meanAnomaly, equationOfCenter, d)
-		transit           = transit(d, meanAnomaly, eclipticLongitude)
-		declination       = declination(eclipticLongitude)
+		transit     = sun.transit
+		declination = sun.declination
 		// https://solarsena.com/solar-elevation-angle-altitude/
-		numerator   = math.Sin(elevation*Degree) - (math.Sin(latitude*Degree) * math.Sin(declination*Degree))
-		denominator = math.Cos(latitude*Degree) * math.Cos(declination*Degree)
-		hourAngle   = math.Acos(numerator / denominator)
-		frac        = hourAngle / (2 * math.Pi)
-		morningJD   = transit - frac
-		eveningJD   = transit + frac
+		numerator    = math.Sin(elevation*Degree) - (math.Sin(latitude*Degree) * math.Sin(declination*Degree))
+		denominator  = math.Cos(latitude*Degree) * math.Cos(declination*Degree)
+		cosHourAngle = numerator / denominator
 	)
 
 	// Check for cases where the sun never reaches the given elevation.
-	if math.IsNaN(hourAngle) {
-		return time.Time{}, time.Time{}
+	if cosHourAngle > 1 {
+		return time.Time{}, time.Time{}, ErrSunNeverRises
+	}
+	if cosHourAngle < -1 {
+		return time.Time{}, time.Time{}, ErrSunNeverSets
 	}
 
+	var (
+		hourAngle = math.Acos(cosHourAngle)
+		frac      = hourAngle / (2 * math.Pi)
+		morningJD = transit - frac
+		eveningJD = transit + frac
+	)
 	morning = JulianDayToTime(morningJD)
 	evening = JulianDayToTime(eveningJD)
-	return morning, evening
+	return morning, evening, nil
 }
 
 // TimeOfElevation calculates the times of day when the sun is at a given elevation
 // above the horizon on a given day at the specified location.
 //
-// All times are calculated and returned in UTC. Useful for calculating twilight times,
-// golden hour, etc.
+// Times are returned in UTC, or within the local civil day and in the time zone
+// of a Time created with NewTimeIn. Useful for calculating twilight times,
+// golden hour, etc. Use TimeOfElevationErr to find out why the elevation is not
+// reached.
 //
 // Common elevation angles:
 //   - -0.833°: Official sunrise/sunset (accounts for atmospheric refraction)
@@ -49,7 +121,8 @@ func timeOfElevationInternal(latitude, longitude, elevation float64, year int, m
 // Parameters:
 //   - loc: Location created via NewLocation() or NewLocationFromNMEA()
 //   - elevation: Solar elevation angle in degrees (negative for below horizon)
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
 //
 // Returns:
 //   - morning: Time in UTC when sun reaches elevation in the morning (time.Time{} if never reached)
@@ -61,31 +134,105 @@ func timeOfElevationInternal(latitude, longitude, elevation float64, year int, m
 //	t := solar.NewTime(2024, time.June, 21)
 //	// Calculate civil twilight times
 //	morning, evening := solar.TimeOfElevation(loc, -6.0, t)
-func TimeOfElevation(loc Location, elevation float64, t Time) (morning, evening time.Time) {
-	return timeOfElevationInternal(loc.Latitude(), loc.Longitude(), elevation, t.Year(), t.Month(), t.Day())
+//
+//	// Calculate them with the NOAA algorithm
+//	morning, evening = solar.TimeOfElevation(loc, -6.0, t, solar.NOAA)
+func TimeOfElevation(loc Location, elevation float64, t Time, algorithm ...Algorithm) (morning, evening time.Time) {
+	alg := selectAlgorithm(loc, algorithm)
+	if t.zone == nil {
+		morning, evening, _ = timeOfElevationAt(alg, loc, elevation, t.Year(), t.Month(), t.Day())
+		return morning, evening
+	}
+
+	// The morning and evening of a local day may come from different UTC dates
+	morning, _ = morningOfElevation(alg, loc, elevation, t)
+	evening, _ = eveningOfElevation(alg, loc, elevation, t)
+	return morning, evening
 }
 
-// elevationInternal is the internal implementation with old signature
-func elevationInternal(latitude, longitude float64, when time.Time) float64 {
-	var (
-		d                 = meanSolarNoonInternal(longitude, when.Year(), when.Month(), when.Day())
-		meanAnomaly       = meanAnomaly(d)
-		equationOfCenter  = equationOfCenter(meanAnomaly)
-		eclipticLongitude = eclipticLongitude(meanAnomaly, equationOfCenter, d)
-		transit           = transit(d, meanAnomaly, eclipticLongitude)
-		declination       = declination(eclipticLongitude)
-		frac              = transit - TimeToJulianDay(when)
-		hourAngle         = 2 * math.Pi * frac
-		// https://solarsena.com/solar-elevation-angle-altitude/
-		firstPart  = math.Sin(latitude*Degree) * math.Sin(declination*Degree)
-		secondPart = math.Cos(latitude*Degree) * math.Cos(declination*Degree) * math.Cos(hourAngle)
-	)
+// TimeOfElevationErr is like TimeOfElevation, but reports why the sun does not
+// reach the elevation instead of returning zero times.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - elevation: Solar elevation angle in degrees (negative for below horizon)
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
+//
+// Returns:
+//   - morning: Time in UTC, or in the time zone of t, when the sun reaches the elevation in the morning
+//   - evening: Time in UTC, or in the time zone of t, when the sun reaches the elevation in the evening
+//   - error: an *ElevationError if the sun stays above or below the elevation all day, or
+//     ErrNoEventOnDay if a crossing falls outside the local civil day of t
+//
+// Example:
+//
+//	loc := solar.NewLocation(69.65, 18.96) // Tromsø
+//	t := solar.NewTime(2024, time.June, 21)
+//	morning, evening, err := solar.TimeOfElevationErr(loc, 0, t)
+//	if errors.Is(err, solar.ErrSunNeverSets) {
+//	    // Midnight sun: the sun stays above 0° all day
+//	}
+func TimeOfElevationErr(loc Location, elevation float64, t Time, algorithm ...Algorithm) (morning, evening time.Time, err error) {
+	alg := selectAlgorithm(loc, algorithm)
+	if t.zone == nil {
+		return timeOfElevationAt(alg, loc, elevation, t.Year(), t.Month(), t.Day())
+	}
+
+	// The morning and evening of a local day may come from different UTC dates
+	if morning, err = morningOfElevation(alg, loc, elevation, t); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if evening, err = eveningOfElevation(alg, loc, elevation, t); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return morning, evening, nil
+}
+
+// morningOfElevation returns the morning time at which the sun reaches the
+// elevation on the day of t, computed with the given algorithm.
+func morningOfElevation(algorithm Algorithm, loc Location, elevation float64, t Time) (time.Time, error) {
+	return onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		morning, _, err := timeOfElevationAt(algorithm, loc, elevation, year, month, day)
+		return morning, err
+	})
+}
 
-	return math.Asin(firstPart+secondPart) / Degree
+// eveningOfElevation returns the evening time at which the sun reaches the
+// elevation on the day of t, computed with the given algorithm.
+func eveningOfElevation(algorithm Algorithm, loc Location, elevation float64, t Time) (time.Time, error) {
+	return onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		_, evening, err := timeOfElevationAt(algorithm, loc, elevation, year, month, day)
+		return evening, err
+	})
+}
+
+// timeOfElevationAt dispatches to the implementation of the given algorithm,
+// refining the times if the location asks for it. Times are zero, with an
+// *ElevationError, if the sun never reaches the elevation.
+func timeOfElevationAt(algorithm Algorithm, loc Location, elevation float64, year int, month time.Month, day int) (morning, evening time.Time, err error) {
+	if algorithm == SunriseEquation {
+		morning, evening, err = timeOfElevationInternal(loc.Latitude(), loc.Longitude(), elevation, loc.SecularTerms(), year, month, day)
+	} else {
+		morning, evening, err = timeOfElevationAlgorithm(algorithm, loc.Latitude(), loc.Longitude(), elevation, year, month, day)
+	}
+	if err != nil {
+		return time.Time{}, time.Time{}, elevationError(elevation, err)
+	}
+
+	if loc.Refinement() {
+		morning = refineEvent(algorithm, loc, elevation, morning)
+		evening = refineEvent(algorithm, loc, elevation, evening)
+	}
+	return morning, evening, nil
 }
 
 // Elevation calculates the angle of the sun above the horizon at a given moment
-// at the specified location.
+// at the specified location. Use Position to obtain the elevation together with
+// the azimuth and the other solar coordinates in a single calculation.
+//
+// This is the true (geometric) elevation, without atmospheric refraction. Use
+// ApparentElevation for the elevation at which the sun is actually seen.
 //
 // The time parameter should be in UTC. If you have a local time, convert it to UTC first
 // using time.UTC() or time.In(time.UTC).
@@ -93,6 +240,7 @@ func elevationInternal(latitude, longitude float64, when time.Time) float64 {
 // Parameters:
 //   - loc: Location created via NewLocation() or NewLocationFromNMEA()
 //   - when: The moment in time to calculate elevation (in UTC)
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
 //
 // Returns:
 //   - Solar elevation angle in degrees (positive above horizon, negative below)
@@ -102,6 +250,31 @@ func elevationInternal(latitude, longitude float64, when time.Time) float64 {
 //	loc := solar.NewLocation(40.7128, -74.0060)
 //	elevation := solar.Elevation(loc, time.Now().UTC())
 //	// elevation is in degrees, positive above horizon, negative below
-func Elevation(loc Location, when time.Time) float64 {
-	return elevationInternal(loc.Latitude(), loc.Longitude(), when)
+//
+//	// Use the NREL Solar Position Algorithm for high accuracy
+//	elevation = solar.Elevation(loc, time.Now().UTC(), solar.SPA)
+func Elevation(loc Location, when time.Time, algorithm ...Algorithm) float64 {
+	return Position(loc, when, algorithm...).Elevation
+}
+
+// ApparentElevation calculates the elevation at which the sun is seen at a given
+// moment at the specified location, that is its true elevation raised by
+// atmospheric refraction. Refraction is computed with the location's refraction
+// model (RefractionSPA unless set with WithRefraction) and, for RefractionSPA,
+// the observer's pressure and temperature.
+//
+// Parameters:
+//   - loc: Location created via NewLocation(), NewObserver() or NewLocationFromNMEA()
+//   - when: The moment in time to calculate elevation (in UTC)
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
+//
+// Returns:
+//   - Apparent solar elevation angle in degrees (positive above horizon, negative below)
+//
+// Example:
+//
+//	obs := solar.NewObserver(39.742476, -105.1786, 1830).WithPressure(820).WithTemperature(11)
+//	apparent := solar.ApparentElevation(obs, time.Now().UTC(), solar.SPA)
+func ApparentElevation(loc Location, when time.Time, algorithm ...Algorithm) float64 {
+	return Position(loc, when, algorithm...).ApparentElevation
 }
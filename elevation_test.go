@@ -1,6 +1,7 @@
 package solar
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -100,6 +101,131 @@ func TestTimeOfElevation(t *testing.T) {
 	}
 }
 
+// TestTimeOfElevation_Algorithm checks that the algorithm given for one call
+// overrides the location's
+func TestTimeOfElevation_Algorithm(t *testing.T) {
+	loc := NewLocation(51.5072, -0.1276) // London
+	tm := NewTimeIn(2024, time.March, 20, time.UTC)
+
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		wantMorning, wantEvening := TimeOfElevation(loc.WithAlgorithm(algorithm), -8.5, tm)
+		morning, evening := TimeOfElevation(loc, -8.5, tm, algorithm)
+		if !morning.Equal(wantMorning) || !evening.Equal(wantEvening) {
+			t.Errorf("TimeOfElevation(%s) = %s, %s, want %s, %s", algorithm, morning, evening, wantMorning, wantEvening)
+		}
+
+		morning, evening, err := TimeOfElevationErr(loc.WithAlgorithm(SPA), -8.5, NewTime(2024, time.March, 20), algorithm)
+		wantMorning, wantEvening, wantErr := TimeOfElevationErr(loc.WithAlgorithm(algorithm), -8.5, NewTime(2024, time.March, 20))
+		if err != wantErr || !morning.Equal(wantMorning) || !evening.Equal(wantEvening) {
+			t.Errorf("TimeOfElevationErr(%s) = %s, %s, %v, want %s, %s, %v", algorithm, morning, evening, err, wantMorning, wantEvening, wantErr)
+		}
+	}
+}
+
+func TestTimeOfElevationErr(t *testing.T) {
+	loc := NewLocation(51.5072, -0.1276)
+	tm := NewTime(2022, time.June, 21)
+
+	tests := []struct {
+		name      string
+		elevation float64
+		above     bool
+		want      error
+	}{
+		{"too high", 61.94, false, ErrSunNeverRises},
+		{"too low", -16, true, ErrSunNeverSets},
+	}
+
+	for _, tt := range tests {
+		for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+			t.Run(tt.name+"/"+algorithm.String(), func(t *testing.T) {
+				morning, evening, err := TimeOfElevationErr(loc.WithAlgorithm(algorithm), tt.elevation, tm)
+				if !morning.IsZero() || !evening.IsZero() {
+					t.Errorf("TimeOfElevationErr() = %s, %s, want zero times", morning, evening)
+				}
+				if !errors.Is(err, tt.want) {
+					t.Errorf("TimeOfElevationErr() error = %v, want %v", err, tt.want)
+				}
+				var elevErr *ElevationError
+				if !errors.As(err, &elevErr) {
+					t.Fatalf("TimeOfElevationErr() error = %v, want an *ElevationError", err)
+				}
+				if elevErr.Elevation != tt.elevation || elevErr.Above != tt.above {
+					t.Errorf("ElevationError = %+v, want elevation %g, above %t", *elevErr, tt.elevation, tt.above)
+				}
+			})
+		}
+	}
+
+	// Reached elevations match TimeOfElevation
+	wantMorning, wantEvening := TimeOfElevation(loc, -8.5, tm)
+	morning, evening, err := TimeOfElevationErr(loc, -8.5, tm)
+	if err != nil {
+		t.Fatalf("TimeOfElevationErr() error = %v", err)
+	}
+	if !morning.Equal(wantMorning) || !evening.Equal(wantEvening) {
+		t.Errorf("TimeOfElevationErr() = %s, %s, want %s, %s", morning, evening, wantMorning, wantEvening)
+	}
+}
+
+func TestTimeOfElevationErr_Zone(t *testing.T) {
+	zone := time.FixedZone("BST", 60*60)
+	loc := NewLocation(51.5072, -0.1276)
+
+	morning, evening, err := TimeOfElevationErr(loc, -8.5, NewTimeIn(2022, time.August, 27, zone))
+	if err != nil {
+		t.Fatalf("TimeOfElevationErr() error = %v", err)
+	}
+	if morning.Location() != zone || evening.Location() != zone {
+		t.Errorf("locations = %v, %v, want %v", morning.Location(), evening.Location(), zone)
+	}
+
+	var elevErr *ElevationError
+	if _, _, err := TimeOfElevationErr(loc, -16, NewTimeIn(2022, time.June, 21, zone)); !errors.As(err, &elevErr) || !elevErr.Above {
+		t.Errorf("TimeOfElevationErr(-16) error = %v, want the sun always above", err)
+	}
+}
+
+func TestElevationError(t *testing.T) {
+	above := &ElevationError{Elevation: -18, Above: true}
+	below := &ElevationError{Elevation: -6}
+
+	if got, want := above.Error(), "sun stays above -18° all day"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if got, want := below.Error(), "sun stays below -6° all day"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	// The horizon, converted from radians, is rounded to a thousandth of a degree
+	for _, e := range Timeline(NewLocation(78.22, 15.65), NewTime(2024, time.December, 21)) {
+		if e.Kind != EventSunrise {
+			continue
+		}
+		if e.Err == nil {
+			t.Fatalf("Timeline() sunrise at %s, want none", e.Time)
+		}
+		if got, want := e.Err.Error(), "sun stays below -0.833° all day"; got != want {
+			t.Errorf("Timeline() sunrise error = %q, want %q", got, want)
+		}
+	}
+	if got, want := (&ElevationError{Elevation: 6.4567}).Error(), "sun stays below 6.457° all day"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	if !errors.Is(above, ErrSunNeverSets) || errors.Is(above, ErrSunNeverRises) {
+		t.Errorf("errors.Is(above) does not match only ErrSunNeverSets")
+	}
+	if !errors.Is(below, ErrSunNeverRises) || errors.Is(below, ErrSunNeverSets) {
+		t.Errorf("errors.Is(below) does not match only ErrSunNeverRises")
+	}
+
+	// Other errors are passed through
+	if err := elevationError(0, ErrNoEventOnDay); err != ErrNoEventOnDay {
+		t.Errorf("elevationError(ErrNoEventOnDay) = %v, want %v", err, ErrNoEventOnDay)
+	}
+}
+
 func TestElevation(t *testing.T) {
 	for _, tt := range dataElevation {
 		if tt.outFirst.IsZero() || tt.outSecond.IsZero() {
@@ -0,0 +1,168 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// This file implements the equinox and solstice algorithm of Jean Meeus,
+// "Astronomical Algorithms" (2nd ed.), chapter 27. The instants are accurate to
+// about a minute for the years -1000 to 3000.
+
+// season identifies one of the four equinoxes and solstices.
+type season int
+
+const (
+	marchEquinox season = iota
+	juneSolstice
+	septemberEquinox
+	decemberSolstice
+)
+
+// seasonMeanTerms are the coefficients of the mean instant (JDE0) of each
+// season as a polynomial in Y (Meeus tables 27.A and 27.B).
+var (
+	seasonMeanTermsBefore1000 = [4][5]float64{
+		{1721139.29189, 365242.13740, 0.06134, 0.00111, -0.00071},
+		{1721233.25401, 365241.72562, -0.05323, 0.00907, 0.00025},
+		{1721325.70455, 365242.49558, -0.11677, -0.00297, 0.00074},
+		{1721414.39987, 365242.88257, -0.00769, -0.00933, -0.00006},
+	}
+	seasonMeanTermsAfter1000 = [4][5]float64{
+		{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+		{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+		{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+		{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+	}
+)
+
+// seasonPeriodicTerms are the periodic terms A·cos(B + C·T) of the correction
+// to the mean instant (Meeus table 27.C). B and C are in degrees.
+var seasonPeriodicTerms = [24]struct {
+	a, b, c float64
+}{
+	{485, 324.96, 1934.136},
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// seasonJDE returns the Julian Ephemeris Day (Terrestrial Time) of the given
+// equinox or solstice in the given year.
+func seasonJDE(year int, s season) float64 {
+	var (
+		terms = seasonMeanTermsAfter1000[s]
+		y     = float64(year-2000) / 1000
+	)
+	if year < 1000 {
+		terms = seasonMeanTermsBefore1000[s]
+		y = float64(year) / 1000
+	}
+	jde0 := terms[0] + y*(terms[1]+y*(terms[2]+y*(terms[3]+y*terms[4])))
+
+	var (
+		t      = (jde0 - J2000) / JulianCenturyDays
+		w      = (35999.373*t - 2.47) * Degree
+		lambda = 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+		sum    float64
+	)
+	for _, term := range seasonPeriodicTerms {
+		sum += term.a * math.Cos((term.b+term.c*t)*Degree)
+	}
+	return jde0 + 0.00001*sum/lambda
+}
+
+// MarchEquinox returns the instant of the March (northward) equinox in the
+// given year, when the sun's apparent ecliptic longitude is 0°. This is the
+// start of spring in the northern hemisphere.
+//
+// The instant follows Meeus, "Astronomical Algorithms", chapter 27, and is
+// accurate to about a minute for the years -1000 to 3000.
+//
+// Parameters:
+//   - year: Year (e.g., 2025)
+//
+// Returns:
+//   - The instant of the equinox in UTC
+//
+// Example:
+//
+//	equinox := solar.MarchEquinox(2025)
+//	// 2025-03-20 09:01 UTC
+func MarchEquinox(year int) time.Time {
+	return JulianDayToTime(seasonJDE(year, marchEquinox), TT)
+}
+
+// JuneSolstice returns the instant of the June solstice in the given year,
+// when the sun's apparent ecliptic longitude is 90°. This is the start of
+// summer in the northern hemisphere.
+//
+// Parameters:
+//   - year: Year (e.g., 2025)
+//
+// Returns:
+//   - The instant of the solstice in UTC
+//
+// Example:
+//
+//	solstice := solar.JuneSolstice(2025)
+//	// 2025-06-21 02:42 UTC
+func JuneSolstice(year int) time.Time {
+	return JulianDayToTime(seasonJDE(year, juneSolstice), TT)
+}
+
+// SeptemberEquinox returns the instant of the September (southward) equinox
+// in the given year, when the sun's apparent ecliptic longitude is 180°. This
+// is the start of autumn in the northern hemisphere.
+//
+// Parameters:
+//   - year: Year (e.g., 2025)
+//
+// Returns:
+//   - The instant of the equinox in UTC
+//
+// Example:
+//
+//	equinox := solar.SeptemberEquinox(2025)
+//	// 2025-09-22 18:19 UTC
+func SeptemberEquinox(year int) time.Time {
+	return JulianDayToTime(seasonJDE(year, septemberEquinox), TT)
+}
+
+// DecemberSolstice returns the instant of the December solstice in the given
+// year, when the sun's apparent ecliptic longitude is 270°. This is the start
+// of winter in the northern hemisphere.
+//
+// Parameters:
+//   - year: Year (e.g., 2025)
+//
+// Returns:
+//   - The instant of the solstice in UTC
+//
+// Example:
+//
+//	solstice := solar.DecemberSolstice(2025)
+//	// 2025-12-21 15:03 UTC
+func DecemberSolstice(year int) time.Time {
+	return JulianDayToTime(seasonJDE(year, decemberSolstice), TT)
+}
@@ -0,0 +1,91 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestSeasonJDE checks example 27.a of Meeus, "Astronomical Algorithms": the
+// June solstice of 1962 at JDE 2437837.39245 (1962 June 21, 21:25:08 TD).
+func TestSeasonJDE(t *testing.T) {
+	if jde := seasonJDE(1962, juneSolstice); !AlmostEqual(jde, 2437837.39245, 0.00001) {
+		t.Errorf("seasonJDE(1962, June) = %.5f, want 2437837.39245", jde)
+	}
+}
+
+// dataSeasons holds the equinoxes and solstices published by the U.S. Naval
+// Observatory, to the minute in UTC.
+var dataSeasons = []struct {
+	year                             int
+	march, june, september, december string
+}{
+	{2000, "03-20 07:35", "06-21 01:48", "09-22 17:27", "12-21 13:37"},
+	{2024, "03-20 03:06", "06-20 20:51", "09-22 12:44", "12-21 09:21"},
+	{2025, "03-20 09:01", "06-21 02:42", "09-22 18:19", "12-21 15:03"},
+}
+
+func TestSeasons(t *testing.T) {
+	for _, tt := range dataSeasons {
+		events := []struct {
+			name string
+			got  time.Time
+			want string
+		}{
+			{"MarchEquinox", MarchEquinox(tt.year), tt.march},
+			{"JuneSolstice", JuneSolstice(tt.year), tt.june},
+			{"SeptemberEquinox", SeptemberEquinox(tt.year), tt.september},
+			{"DecemberSolstice", DecemberSolstice(tt.year), tt.december},
+		}
+		for _, e := range events {
+			want := parseUTC(t, tt.year, e.want)
+			if d := e.got.Sub(want); d < -time.Minute || d > time.Minute {
+				t.Errorf("%s(%d) = %s, want %s", e.name, tt.year, e.got, want)
+			}
+			if e.got.Location() != time.UTC {
+				t.Errorf("%s(%d) location = %v, want UTC", e.name, tt.year, e.got.Location())
+			}
+		}
+	}
+}
+
+// TestSeasons_Longitude checks that the SPA puts the sun at the expected
+// apparent ecliptic longitude at each equinox and solstice, across the range
+// of both tables of Meeus.
+func TestSeasons_Longitude(t *testing.T) {
+	for _, year := range []int{-500, 800, 1582, 1900, 2050, 2500} {
+		events := []struct {
+			when      time.Time
+			longitude float64
+		}{
+			{MarchEquinox(year), 0},
+			{JuneSolstice(year), 90},
+			{SeptemberEquinox(year), 180},
+			{DecemberSolstice(year), 270},
+		}
+		for _, e := range events {
+			got := spaAt(0, 0, 0, e.when).apparentLongitude
+			if d := math.Abs(signedDegrees(got - e.longitude)); d > 0.002 {
+				t.Errorf("%d: longitude at %s = %.5f, want %.0f", year, e.when, got, e.longitude)
+			}
+		}
+	}
+}
+
+// parseUTC parses a "01-02 15:04" month, day and time in the given year.
+func parseUTC(t *testing.T, year int, value string) time.Time {
+	t.Helper()
+	when, err := time.Parse("01-02 15:04", value)
+	if err != nil {
+		t.Fatalf("time.Parse(%q) error = %v", value, err)
+	}
+	return when.AddDate(year, 0, 0)
+}
+
+// BenchmarkMarchEquinox benchmarks the equinox calculation
+func BenchmarkMarchEquinox(b *testing.B) {
+	b.ResetTimer()
+	for b.Loop() {
+		_ = MarchEquinox(2025)
+	}
+}
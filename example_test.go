@@ -1,7 +1,11 @@
 package solar_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/mstephenholl/go-solar"
@@ -172,6 +176,103 @@ func ExampleAzimuth() {
 	// Azimuth: 174.8 degrees (South)
 }
 
+// ExamplePosition demonstrates computing the full solar position at once.
+func ExamplePosition() {
+	// Create location for Toronto
+	loc := solar.NewLocation(43.65, -79.38)
+
+	// Calculate the position for January 1, 2000 at 5:00 PM UTC
+	when := time.Date(2000, time.January, 1, 17, 0, 0, 0, time.UTC)
+	pos := solar.Position(loc, when, solar.SPA)
+
+	fmt.Printf("Elevation: %.1f degrees\n", pos.Elevation)
+	fmt.Printf("Azimuth: %.1f degrees\n", pos.Azimuth)
+	fmt.Printf("Declination: %.1f degrees\n", pos.Declination)
+	fmt.Printf("Equation of time: %.1f minutes\n", pos.EquationOfTime)
+	fmt.Printf("Distance: %.4f AU\n", pos.Distance)
+	// Output:
+	// Elevation: 23.2 degrees
+	// Azimuth: 174.8 degrees
+	// Declination: -23.0 degrees
+	// Equation of time: -3.4 minutes
+	// Distance: 0.9833 AU
+}
+
+// ExampleNewTimeIn demonstrates computing events for a local civil day.
+// Kiritimati keeps UTC+14 although it lies at 157° W, so the sunrise of a UTC
+// date falls on the next local day.
+func ExampleNewTimeIn() {
+	loc := solar.NewLocation(1.87, -157.4)
+	zone := time.FixedZone("LINT", 14*60*60)
+
+	utc, _ := solar.Sunrise(loc, solar.NewTime(2024, time.January, 15))
+	local, _ := solar.Sunrise(loc, solar.NewTimeIn(2024, time.January, 15, zone))
+
+	fmt.Printf("UTC date:  %s\n", utc.In(zone).Format("Jan 2 15:04 MST"))
+	fmt.Printf("Local day: %s\n", local.Format("Jan 2 15:04 MST"))
+	// Output:
+	// UTC date:  Jan 16 06:38 LINT
+	// Local day: Jan 15 06:37 LINT
+}
+
+// ExampleMarchEquinox demonstrates finding the season boundaries of a year.
+func ExampleMarchEquinox() {
+	fmt.Printf("March equinox:     %s\n", solar.MarchEquinox(2025).Format("Jan 2 15:04 MST"))
+	fmt.Printf("June solstice:     %s\n", solar.JuneSolstice(2025).Format("Jan 2 15:04 MST"))
+	fmt.Printf("September equinox: %s\n", solar.SeptemberEquinox(2025).Format("Jan 2 15:04 MST"))
+	fmt.Printf("December solstice: %s\n", solar.DecemberSolstice(2025).Format("Jan 2 15:04 MST"))
+	// Output:
+	// March equinox:     Mar 20 09:01 UTC
+	// June solstice:     Jun 21 02:42 UTC
+	// September equinox: Sep 22 18:19 UTC
+	// December solstice: Dec 21 15:03 UTC
+}
+
+// ExampleEarthSunDistance demonstrates the distance, apparent size and
+// top-of-atmosphere irradiance of the sun at perihelion.
+func ExampleEarthSunDistance() {
+	when := time.Date(2025, time.January, 4, 13, 28, 0, 0, time.UTC)
+
+	fmt.Printf("Distance:   %.4f AU\n", solar.EarthSunDistance(when, solar.SPA))
+	fmt.Printf("Radius:     %.2f'\n", solar.SunAngularRadius(when, solar.SPA)*60)
+	fmt.Printf("Irradiance: %.0f W/m²\n", solar.ExtraterrestrialIrradiance(when, solar.SPA))
+	// Output:
+	// Distance:   0.9833 AU
+	// Radius:     16.27'
+	// Irradiance: 1408 W/m²
+}
+
+// ExampleLocalApparentSolarTime demonstrates reading a sundial: the apparent
+// solar time runs ahead of the mean solar time by the equation of time.
+func ExampleLocalApparentSolarTime() {
+	loc := solar.NewLocation(51.48, -2.59) // Bristol
+	when := time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC)
+
+	fmt.Printf("Mean solar time:     %s\n", solar.LocalMeanSolarTime(loc, when).Format("15:04:05 MST"))
+	fmt.Printf("Apparent solar time: %s\n", solar.LocalApparentSolarTime(loc, when).Format("15:04:05 MST"))
+	fmt.Printf("Equation of time:    %.1f min\n", solar.EquationOfTime(when))
+	// Output:
+	// Mean solar time:     11:49:38 LMT
+	// Apparent solar time: 12:06:06 LAT
+	// Equation of time:    16.5 min
+}
+
+// ExampleSolarNoon demonstrates finding the highest and lowest points of the
+// sun on a summer day above the Arctic Circle.
+func ExampleSolarNoon() {
+	loc := solar.NewLocation(69.65, 18.96) // Tromsø
+	t := solar.NewTime(2024, time.June, 21)
+
+	noon := solar.SolarNoon(loc, t)
+	midnight := solar.SolarMidnight(loc, t)
+
+	fmt.Printf("Solar midnight: %s, %.1f°\n", midnight.Time.Format("Jan 2 15:04 MST"), midnight.Elevation)
+	fmt.Printf("Solar noon:     %s, %.1f°\n", noon.Time.Format("Jan 2 15:04 MST"), noon.Elevation)
+	// Output:
+	// Solar midnight: Jun 20 22:45 UTC, 3.1°
+	// Solar noon:     Jun 21 10:45 UTC, 43.8°
+}
+
 // ExampleDawn demonstrates calculating civil dawn (beginning of morning twilight).
 func ExampleDawn() {
 	// Toronto coordinates
@@ -246,6 +347,116 @@ func ExampleDawnDusk_astronomical() {
 	// Astronomical dusk: 23:34 UTC
 }
 
+// ExampleDawnDuskWith demonstrates choosing the algorithm for one call of
+// DawnDusk, whose optional argument is the twilight type.
+func ExampleDawnDuskWith() {
+	// Toronto coordinates
+	loc := solar.NewLocation(43.65, -79.38)
+	t := solar.NewTime(2000, time.January, 1)
+
+	for _, algorithm := range []solar.Algorithm{solar.SunriseEquation, solar.NOAA, solar.SPA} {
+		dawn, dusk := solar.DawnDuskWith(loc, t, algorithm, solar.Nautical)
+		fmt.Printf("%-15s %s to %s\n", algorithm, dawn.Format("15:04:05"), dusk.Format("15:04:05"))
+	}
+	// Output:
+	// SunriseEquation 11:42:10 to 22:59:27
+	// NOAA            11:42:14 to 22:59:45
+	// SPA             11:42:13 to 22:59:44
+}
+
+// ExampleDawnDuskErr demonstrates telling a white night from a polar night.
+func ExampleDawnDuskErr() {
+	places := []struct {
+		name     string
+		loc      solar.Location
+		t        solar.Time
+		twilight solar.TwilightType
+	}{
+		{"London", solar.NewLocation(51.5072, -0.1276), solar.NewTime(2024, time.June, 21), solar.Astronomical},
+		{"Longyearbyen", solar.NewLocation(78.22, 15.65), solar.NewTime(2024, time.December, 21), solar.Civil},
+	}
+
+	for _, p := range places {
+		_, _, err := solar.DawnDuskErr(p.loc, p.t, p.twilight)
+		var elevErr *solar.ElevationError
+		if errors.As(err, &elevErr) && elevErr.Above {
+			fmt.Printf("%s: white night, %v\n", p.name, err)
+		} else if errors.Is(err, solar.ErrSunNeverRises) {
+			fmt.Printf("%s: polar night, %v\n", p.name, err)
+		}
+	}
+	// Output:
+	// London: white night, sun stays above -18° all day
+	// Longyearbyen: polar night, sun stays below -6° all day
+}
+
+// ExampleEveningTwilight demonstrates the golden and blue hours and a custom
+// twilight type.
+func ExampleEveningTwilight() {
+	loc := solar.NewLocation(40.7128, -74.0060) // New York City
+	t := solar.NewTimeIn(2024, time.June, 21, time.FixedZone("EDT", -4*60*60))
+
+	for _, twilightType := range []solar.TwilightType{solar.GoldenHour, solar.BlueHour} {
+		start, end, err := solar.EveningTwilight(loc, t, twilightType)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf("%s: %s to %s\n", twilightType, start.Format("15:04"), end.Format("15:04"))
+	}
+
+	// Nightfall at 8.5° below the horizon
+	tzeit := solar.NewTwilightType(solar.CivilTwilightAngle, -8.5)
+	fmt.Printf("Tzeit: %s\n", solar.Dusk(loc, t, tzeit).Format("15:04 MST"))
+	// Output:
+	// GoldenHour: 19:49 to 20:50
+	// BlueHour: 20:50 to 21:04
+	// Tzeit: 21:21 EDT
+}
+
+// ExampleDayPhaseAt demonstrates classifying the sky at an instant.
+func ExampleDayPhaseAt() {
+	loc := solar.NewLocation(43.65, -79.38) // Toronto
+	zone := time.FixedZone("EST", -5*60*60)
+
+	for _, hour := range []int{7, 12, 18} {
+		p := solar.DayPhaseAt(loc, time.Date(2024, time.February, 1, hour, 0, 0, 0, zone))
+		fmt.Printf("%02d:00 %s (%.1f°) from %s to %s\n",
+			hour, p.Phase, p.Elevation, p.Start.Format("15:04"), p.End.Format("15:04"))
+	}
+	// Output:
+	// 07:00 MorningNauticalTwilight (-6.7°) from 06:29 to 07:04
+	// 12:00 Day (28.7°) from 08:17 to 16:44
+	// 18:00 EveningNauticalTwilight (-6.4°) from 17:57 to 18:32
+}
+
+// ExampleTimeline demonstrates listing every solar event of a local day.
+func ExampleTimeline() {
+	loc := solar.NewLocation(69.65, 18.96) // Tromsø
+	t := solar.NewTimeIn(2024, time.February, 1, time.FixedZone("CET", 60*60))
+
+	for _, e := range solar.Timeline(loc, t) {
+		if !e.Occurs() {
+			fmt.Printf("%-16s -\n", e.Kind)
+			continue
+		}
+		fmt.Printf("%-16s %s %6.1f°\n", e.Kind, e.Time.Format("15:04"), e.Position.Elevation)
+	}
+	// Output:
+	// AstronomicalDawn 05:36  -18.0°
+	// NauticalDawn     06:46  -12.0°
+	// CivilDawn        08:03   -6.0°
+	// Sunrise          09:28   -0.8°
+	// SolarNoon        11:57    3.1°
+	// Sunset           14:27   -0.8°
+	// CivilDusk        15:51   -6.0°
+	// NauticalDusk     17:08  -12.0°
+	// AstronomicalDusk 18:18  -18.0°
+	// SolarMidnight    23:57  -37.6°
+	// GoldenHourEnd    -
+	// GoldenHourStart  -
+}
+
 // ExampleNewLocationFromNMEA demonstrates parsing location from an NMEA GPS sentence.
 func ExampleNewLocationFromNMEA() {
 	// Parse an NMEA RMC sentence (includes date)
@@ -280,6 +491,121 @@ func ExampleNewLocationFromNMEA_gga() {
 	// Longitude: 11.5167
 }
 
+// ExampleNMEASession demonstrates dating GGA fixes from an earlier RMC sentence,
+// across UTC midnight.
+func ExampleNMEASession() {
+	session := solar.NewNMEASession()
+	for _, sentence := range []string{
+		"$GPRMC,235959,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*66",
+		"$GPGGA,000001,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*4B",
+	} {
+		fix, err := session.Parse(sentence)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Println(fix.Sentence, fix.Time.Format(time.DateTime))
+	}
+
+	loc, t, err := session.Current()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	sunrise, err := solar.Sunrise(loc, t)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println("Sunrise:", sunrise.Format(time.DateTime))
+	// Output:
+	// RMC 1994-03-23 23:59:59
+	// GGA 1994-03-24 00:00:01
+	// Sunrise: 1994-03-24 05:08:39
+}
+
+// ExampleNMEASession_metadata demonstrates reading the local zone, course and
+// dilution of precision from ZDA, VTG and GSA sentences.
+func ExampleNMEASession_metadata() {
+	session := solar.NewNMEASession()
+	for _, sentence := range []string{
+		"$GPZDA,201530.00,04,07,2002,05,00*65",
+		"$GPVTG,054.7,T,034.4,M,005.5,N,010.2,K,A*25",
+		"$GPGSA,A,3,04,05,,09,12,,,24,,,,,2.5,1.3,2.1*39",
+		"$GPGLL,4916.45,N,12311.12,W,225444,A,A*5C",
+	} {
+		fix, err := session.Parse(sentence)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		switch fix.Sentence {
+		case "ZDA":
+			fmt.Println("ZDA", fix.Time.In(fix.Zone).Format("2006-01-02 15:04 -07:00"))
+		case "VTG":
+			fmt.Printf("VTG %.1f° at %.1f kn\n", fix.Course, fix.Speed)
+		case "GSA":
+			fmt.Printf("GSA %dD fix, HDOP %.1f, %d satellites\n", fix.FixMode, fix.HDOP, len(fix.SatellitesUsed))
+		default:
+			fmt.Printf("%s %.4f %.4f %s\n", fix.Sentence, fix.Latitude, fix.Longitude, fix.Time.Format(time.DateTime))
+		}
+	}
+	// Output:
+	// ZDA 2002-07-04 15:15 -05:00
+	// VTG 54.7° at 5.5 kn
+	// GSA 3D fix, HDOP 1.3, 5 satellites
+	// GLL 49.2742 -123.1853 2002-07-04 22:54:44
+}
+
+// ExampleNMEAFix_Observer demonstrates rejecting a poor fix and computing
+// sunrise at the antenna's altitude.
+func ExampleNMEAFix_Observer() {
+	session := solar.NewNMEASession()
+	session.SetDate(2024, time.June, 21)
+	fix, err := session.Parse("$GPGGA,123519,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*47")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if fix.Quality == solar.FixInvalid || fix.NumSatellites < 4 || fix.HDOP > 5 {
+		fmt.Println("poor fix")
+		return
+	}
+	fmt.Printf("%s fix, %d satellites, HDOP %.1f, %.1f m\n", fix.Quality, fix.NumSatellites, fix.HDOP, fix.Altitude)
+
+	atSeaLevel, _ := solar.Sunrise(fix.Location(), fix.Date())
+	atAltitude, _ := solar.Sunrise(fix.Observer(), fix.Date())
+	fmt.Println("Sunrise at sea level:", atSeaLevel.Format("15:04:05"))
+	fmt.Println("Sunrise at altitude: ", atAltitude.Format("15:04:05"))
+	// Output:
+	// GPS fix, 8 satellites, HDOP 0.9, 545.4 m
+	// Sunrise at sea level: 03:13:50
+	// Sunrise at altitude:  03:08:36
+}
+
+// ExampleEncodeGGA demonstrates formatting synthetic GPS sentences and parsing
+// them back.
+func ExampleEncodeGGA() {
+	obs := solar.NewObserver(48.1173, 11.5167, 545.4)
+	when := time.Date(2024, time.June, 21, 12, 35, 19, 0, time.UTC)
+
+	gga := solar.EncodeGGA(obs, when, solar.NMEAFix{Quality: solar.FixGPS, NumSatellites: 8, HDOP: 0.9, GeoidSeparation: 46.9})
+	rmc := solar.EncodeRMC(obs, when, solar.NMEAFix{Speed: 22.4, Course: 84.4, MagneticVariation: -3.1})
+	fmt.Println(gga)
+	fmt.Println(rmc)
+
+	loc, err := solar.NewLocationFromNMEA(rmc, 0, 0, 0)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("%.4f %.4f\n", loc.Latitude(), loc.Longitude())
+	// Output:
+	// $GPGGA,123519.00,4807.0380,N,01131.0020,E,1,08,0.9,545.4,M,46.9,M,,*6B
+	// $GPRMC,123519.00,A,4807.0380,N,01131.0020,E,22.4,84.4,210624,3.1,W*4A
+	// 48.1173 11.5167
+}
+
 // ExampleNewTimeFromNMEA demonstrates parsing time from an NMEA GPS sentence.
 func ExampleNewTimeFromNMEA() {
 	// Parse time from an NMEA RMC sentence
@@ -299,6 +625,36 @@ func ExampleNewTimeFromNMEA() {
 	// Day: 23
 }
 
+// ExampleNMEAReader demonstrates reading fixes from a stream of NMEA sentences,
+// skipping the sentences that cannot be used.
+func ExampleNMEAReader() {
+	stream := strings.NewReader("" +
+		"$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6A\r\n" +
+		"$GPRMC,123520,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*00\r\n" +
+		"line noise\r\n" +
+		"$GPGGA,123521,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*4C\r\n")
+
+	reader := solar.NewNMEAReader(stream)
+	defer reader.Close()
+	reader.SetDate(1994, time.March, 23) // for GGA
+
+	for {
+		fix, err := reader.Next(context.Background())
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			fmt.Println("skipped:", errors.Unwrap(err))
+			continue
+		}
+		fmt.Printf("%s %.4f %.4f %s\n", fix.Sentence, fix.Latitude, fix.Longitude, fix.Time.Format("15:04:05"))
+	}
+	// Output:
+	// RMC 48.1173 11.5167 12:35:19
+	// skipped: invalid NMEA checksum: calculated 60, expected 00
+	// GGA 48.1173 11.5167 12:35:21
+}
+
 // ExampleNewLocationFromNMEA_withSolarCalculation demonstrates the complete pattern
 // of parsing NMEA data and using it with solar calculations.
 func ExampleNewLocationFromNMEA_withSolarCalculation() {
@@ -7,10 +7,16 @@ import (
 // hourAngle calculates the second of the two angles required to locate a point
 // on the celestial sphere in the equatorial coordinate system.
 func hourAngle(latitude, declination float64) float64 {
+	return hourAngleAt(latitude, declination, SunriseCorrectionAngle)
+}
+
+// hourAngleAt calculates the hour angle, in degrees, at which the sun's center
+// reaches the given horizon elevation (in radians).
+func hourAngleAt(latitude, declination, horizon float64) float64 {
 	var (
 		latitudeRad    = latitude * Degree
 		declinationRad = declination * Degree
-		numerator      = math.Sin(SunriseCorrectionAngle) - math.Sin(latitudeRad)*math.Sin(declinationRad)
+		numerator      = math.Sin(horizon) - math.Sin(latitudeRad)*math.Sin(declinationRad)
 		denominator    = math.Cos(latitudeRad) * math.Cos(declinationRad)
 	)
 
@@ -9,11 +9,23 @@ import (
 // It can be created from direct latitude/longitude coordinates
 // or parsed from an NMEA GPS sentence.
 type Location struct {
-	latitude  float64
-	longitude float64
+	latitude            float64
+	longitude           float64
+	algorithm           Algorithm
+	height              float64 // meters above sea level
+	pressureOffset      float64 // millibars above StandardPressure
+	temperatureOffset   float64 // degrees Celsius above StandardTemperature
+	refraction          RefractionModel
+	refine              bool
+	timeScale           TimeScale
+	secular             bool
+	varyingSemidiameter bool
 }
 
 // NewLocation creates a Location from latitude and longitude coordinates.
+// The observer is placed at sea level in the standard atmosphere; use
+// NewObserver or the WithHeight, WithPressure and WithTemperature methods to
+// describe the observer more precisely.
 //
 // Parameters:
 //   - latitude: Decimal degrees, positive north, negative south (-90 to +90)
@@ -34,10 +46,13 @@ func NewLocation(latitude, longitude float64) Location {
 // Supported NMEA sentence types:
 //   - GGA (Global Positioning System Fix Data)
 //   - RMC (Recommended Minimum Specific GPS/Transit Data)
+//   - GLL (Geographic Position)
+//   - GNS (GNSS Fix Data)
 //
-// The year, month, and day parameters are required for GGA sentences
-// (which don't include date information). For RMC sentences, these
-// parameters are ignored as the date is parsed from the sentence.
+// The year, month, and day parameters are required for GGA, GLL and GNS
+// sentences (which don't include date information). For RMC sentences, these
+// parameters are ignored as the date is parsed from the sentence. Other
+// supported sentence types carry no position and return ErrInvalidPosition.
 //
 // Parameters:
 //   - nmea: NMEA sentence string (e.g., "$GPGGA,123519,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*47")
@@ -59,15 +74,15 @@ func NewLocation(latitude, longitude float64) Location {
 //	nmea := "$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6A"
 //	loc, err := solar.NewLocationFromNMEA(nmea, 0, 0, 0)
 func NewLocationFromNMEA(nmea string, year int, month time.Month, day int) (Location, error) {
-	pos, err := parseNMEA(nmea, year, month, day)
+	fix, err := parseNMEA(nmea, year, month, day)
 	if err != nil {
 		return Location{}, err
 	}
+	if !fix.HasPosition {
+		return Location{}, fmt.Errorf("%w: %s sentence carries no position", ErrInvalidPosition, fix.Sentence)
+	}
 
-	return Location{
-		latitude:  pos.Latitude,
-		longitude: pos.Longitude,
-	}, nil
+	return fix.Location(), nil
 }
 
 // Latitude returns the latitude in decimal degrees.
@@ -82,6 +97,98 @@ func (l Location) Longitude() float64 {
 	return l.longitude
 }
 
+// Algorithm returns the algorithm used for calculations at this location.
+func (l Location) Algorithm() Algorithm {
+	return l.algorithm
+}
+
+// WithAlgorithm returns a copy of the Location that uses the given algorithm
+// for every calculation, unless a call explicitly selects another one.
+//
+// Example:
+//
+//	loc := solar.NewLocation(40.7128, -74.0060).WithAlgorithm(solar.NOAA)
+//	dawn, dusk := solar.DawnDusk(loc, t) // computed with the NOAA algorithm
+func (l Location) WithAlgorithm(algorithm Algorithm) Location {
+	l.algorithm = algorithm
+	return l
+}
+
+// Refinement reports whether event times are iteratively refined at this
+// location.
+func (l Location) Refinement() bool {
+	return l.refine
+}
+
+// WithRefinement returns a copy of the Location that iteratively refines the
+// times of sunrise, sunset, twilight and other elevation crossings.
+//
+// Without refinement, an event is computed from the sun's position at a single
+// moment (mean solar noon, or the start of the day) assuming a symmetric hour
+// angle, which can be off by several minutes near the poles and around the
+// equinoxes. With refinement, the sun's position is re-evaluated at the
+// candidate time, with the selected algorithm, until the true crossing instant
+// of the altitude is found. Refinement removes the error of evaluating the sun
+// only once; the remaining error is that of the algorithm itself, so combine it
+// with NOAA or SPA for the most accurate times.
+//
+// Example:
+//
+//	loc := solar.NewLocation(69.65, 18.96).WithRefinement(true) // Tromsø
+//	sunrise, err := solar.Sunrise(loc, solar.NewTime(2024, time.March, 20))
+func (l Location) WithRefinement(refine bool) Location {
+	l.refine = refine
+	return l
+}
+
+// SecularTerms reports whether the sunrise equation uses time-varying orbital
+// elements at this location.
+func (l Location) SecularTerms() bool {
+	return l.secular
+}
+
+// WithSecularTerms returns a copy of the Location whose SunriseEquation
+// calculations compute the obliquity of the ecliptic, the eccentricity of the
+// Earth's orbit, the equation of center and the longitude of perihelion as
+// polynomials in Julian centuries, instead of the constants fixed around
+// J2000.
+//
+// The constants are accurate for a few decades around 2000 and keep the
+// calculations as fast as possible; the secular terms keep the declination and
+// the time of solar noon accurate over ±3000 years, at a small cost. The NOAA
+// and SPA algorithms always use time-varying elements and ignore this option.
+//
+// Example:
+//
+//	loc := solar.NewLocation(41.9, 12.5).WithSecularTerms(true) // Rome
+//	sunrise, err := solar.Sunrise(loc, solar.NewTime(1600, time.June, 21))
+func (l Location) WithSecularTerms(secular bool) Location {
+	l.secular = secular
+	return l
+}
+
+// TimeScale returns the time scale in which instants passed to the position
+// functions are read at this location.
+func (l Location) TimeScale() TimeScale {
+	return l.timeScale
+}
+
+// WithTimeScale returns a copy of the Location whose position functions
+// (Position, Elevation, ApparentElevation and Azimuth) read the instants they
+// are given in the given time scale. The default is UTC; use TT for instants
+// taken from an ephemeris or a dynamical-time table, such as historical
+// eclipse or transit predictions.
+//
+// Example:
+//
+//	loc := solar.NewLocation(51.48, 0).WithTimeScale(solar.TT)
+//	when := time.Date(1600, time.June, 21, 12, 0, 0, 0, time.UTC) // 12:00 TT
+//	pos := solar.Position(loc, when, solar.SPA)
+func (l Location) WithTimeScale(scale TimeScale) Location {
+	l.timeScale = scale
+	return l
+}
+
 // String returns a string representation of the Location.
 func (l Location) String() string {
 	latDir := "N"
@@ -98,9 +205,15 @@ func (l Location) String() string {
 
 // Time represents a specific date for solar calculations.
 // It can be created from individual date components or from a time.Time object.
-// All times are treated as UTC.
+//
+// A Time created with NewTime, NewTimeFromDateTime or NewTimeFromNMEA is a UTC
+// date, and events are computed for that date and returned in UTC. A Time
+// created with NewTimeIn or NewTimeFromLocalDateTime carries a time zone: events
+// are then guaranteed to fall within the local civil day, from midnight to
+// midnight in that zone, and are returned in that zone.
 type Time struct {
 	when time.Time
+	zone *time.Location // nil for a UTC date
 }
 
 // NewTime creates a Time from individual date components.
@@ -136,12 +249,56 @@ func NewTimeFromDateTime(when time.Time) Time {
 	}
 }
 
+// NewTimeIn creates a Time for the civil day with the given date in the given
+// time zone. Sunrise, sunset, twilight and the other events are searched for
+// between midnight and the following midnight in that zone, and are returned
+// in that zone.
+//
+// Parameters:
+//   - year: Year (e.g., 2025)
+//   - month: Month (e.g., time.January)
+//   - day: Day of month (e.g., 15)
+//   - zone: Time zone of the day (e.g., from time.LoadLocation). Nil means UTC.
+//
+// Example:
+//
+//	zone, _ := time.LoadLocation("Pacific/Auckland")
+//	t := solar.NewTimeIn(2025, time.January, 15, zone)
+//	sunrise, err := solar.Sunrise(solar.NewLocation(-36.85, 174.76), t)
+//	// sunrise is on January 15 in Auckland, in Auckland time
+func NewTimeIn(year int, month time.Month, day int, zone *time.Location) Time {
+	if zone == nil {
+		zone = time.UTC
+	}
+	return Time{
+		when: time.Date(year, month, day, 0, 0, 0, 0, zone),
+		zone: zone,
+	}
+}
+
+// NewTimeFromLocalDateTime creates a Time for the civil day containing when,
+// in the time zone of when. Unlike NewTimeFromDateTime, the date is not
+// converted to UTC first.
+//
+// Parameters:
+//   - when: A time.Time object in the time zone of interest
+//
+// Example:
+//
+//	// Today's sunset in the system's local time zone
+//	t := solar.NewTimeFromLocalDateTime(time.Now())
+//	sunset, err := solar.Sunset(loc, t)
+func NewTimeFromLocalDateTime(when time.Time) Time {
+	return NewTimeIn(when.Year(), when.Month(), when.Day(), when.Location())
+}
+
 // NewTimeFromNMEA creates a Time from an NMEA GPS sentence.
 // The time is extracted from the NMEA sentence and combined with the provided date.
 //
-// For RMC sentences, the date is parsed from the sentence and the provided
-// year, month, day parameters are ignored. For GGA sentences, the provided
-// date parameters are used.
+// For RMC and ZDA sentences, the date is parsed from the sentence and the
+// provided year, month, day parameters are ignored. For GGA, GLL and GNS
+// sentences, the provided date parameters are used. VTG, GSA and GSV sentences
+// carry no time and return ErrInvalidDate.
 //
 // Parameters:
 //   - nmea: NMEA sentence string
@@ -158,21 +315,36 @@ func NewTimeFromDateTime(when time.Time) Time {
 //	nmea := "$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6A"
 //	t, err := solar.NewTimeFromNMEA(nmea, 0, 0, 0)
 func NewTimeFromNMEA(nmea string, year int, month time.Month, day int) (Time, error) {
-	pos, err := parseNMEA(nmea, year, month, day)
+	fix, err := parseNMEA(nmea, year, month, day)
 	if err != nil {
 		return Time{}, err
 	}
+	if fix.Time.IsZero() {
+		return Time{}, fmt.Errorf("%w: %s sentence carries no time", ErrInvalidDate, fix.Sentence)
+	}
 
 	return Time{
-		when: pos.Time,
+		when: fix.Time,
 	}, nil
 }
 
-// DateTime returns the underlying time.Time value in UTC.
+// DateTime returns the underlying time.Time value. This is the moment parsed
+// from an NMEA sentence, or midnight at the start of the day: in UTC, or in the
+// time zone of a Time created with NewTimeIn or NewTimeFromLocalDateTime.
 func (t Time) DateTime() time.Time {
 	return t.when
 }
 
+// Zone returns the time zone in which events are computed and returned. It is
+// time.UTC unless the Time was created with NewTimeIn or
+// NewTimeFromLocalDateTime.
+func (t Time) Zone() *time.Location {
+	if t.zone == nil {
+		return time.UTC
+	}
+	return t.zone
+}
+
 // Year returns the year component.
 func (t Time) Year() int {
 	return t.when.Year()
@@ -140,6 +140,24 @@ func TestNewLocationFromNMEA(t *testing.T) {
 	}
 }
 
+func TestLocationWithAlgorithm(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	if loc.Algorithm() != SunriseEquation {
+		t.Errorf("default Algorithm() = %v, want SunriseEquation", loc.Algorithm())
+	}
+
+	noaa := loc.WithAlgorithm(NOAA)
+	if noaa.Algorithm() != NOAA {
+		t.Errorf("WithAlgorithm(NOAA).Algorithm() = %v, want NOAA", noaa.Algorithm())
+	}
+	if noaa.Latitude() != loc.Latitude() || noaa.Longitude() != loc.Longitude() {
+		t.Error("WithAlgorithm() changed the coordinates")
+	}
+	if loc.Algorithm() != SunriseEquation {
+		t.Error("WithAlgorithm() modified the original Location")
+	}
+}
+
 func TestLocationString(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -241,6 +259,40 @@ func TestNewTimeFromDateTime(t *testing.T) {
 	}
 }
 
+func TestNewTimeIn(t *testing.T) {
+	zone := time.FixedZone("UTC+14", 14*60*60)
+
+	tests := []struct {
+		name     string
+		tm       Time
+		wantZone *time.Location
+		wantDay  int
+	}{
+		{"Fixed zone", NewTimeIn(2024, time.January, 15, zone), zone, 15},
+		{"Nil zone is UTC", NewTimeIn(2024, time.January, 15, nil), time.UTC, 15},
+		{"Local date kept", NewTimeFromLocalDateTime(time.Date(2024, time.January, 15, 23, 0, 0, 0, zone)), zone, 15},
+		{"UTC date", NewTime(2024, time.January, 15), time.UTC, 15},
+		{"UTC date from local time", NewTimeFromDateTime(time.Date(2024, time.January, 15, 23, 0, 0, 0, zone)), time.UTC, 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.tm.Zone() != tt.wantZone {
+				t.Errorf("Zone() = %v, want %v", tt.tm.Zone(), tt.wantZone)
+			}
+			if tt.tm.Day() != tt.wantDay {
+				t.Errorf("Day() = %v, want %v", tt.tm.Day(), tt.wantDay)
+			}
+		})
+	}
+
+	// The underlying time is local midnight
+	dt := NewTimeIn(2024, time.January, 15, zone).DateTime()
+	if dt.Location() != zone || dt.Hour() != 0 || dt.Day() != 15 {
+		t.Errorf("DateTime() = %v, want 2024-01-15 00:00 in %v", dt, zone)
+	}
+}
+
 func TestNewTimeFromNMEA(t *testing.T) {
 	tests := []struct {
 		name     string
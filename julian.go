@@ -1,11 +1,13 @@
 package solar
 
 import (
+	"math"
 	"time"
 )
 
 const (
 	secondsInADay      = 86400
+	nanosecondsInADay  = secondsInADay * 1e9
 	unixEpochJulianDay = 2440587.5
 )
 
@@ -13,9 +15,16 @@ const (
 //
 // The input time should be in UTC for accurate astronomical calculations.
 // The timezone of the input time is preserved in the calculation via Unix timestamp.
+// Fractions of a second are included, to the resolution of a float64 Julian day
+// (about 40 microseconds today). Use TimeToJulianDayParts to keep every
+// nanosecond.
+//
+// By default the Julian day is in UTC. Pass TT to obtain the Julian Ephemeris
+// Day of the same instant, ΔT later (see TimeScale).
 //
 // Parameters:
 //   - t: Time to convert (should be in UTC for astronomical calculations)
+//   - scale: Optional time scale of the result (UTC, UT1 or TT). Defaults to UTC.
 //
 // Returns:
 //   - Julian day number as float64
@@ -24,17 +33,28 @@ const (
 //
 //	jd := solar.TimeToJulianDay(time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC))
 //	// Returns 2451545.0 (J2000.0 epoch)
-func TimeToJulianDay(t time.Time) float64 {
-	return float64(t.Unix())/secondsInADay + unixEpochJulianDay
+//	jde := solar.TimeToJulianDay(time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), solar.TT)
+//	// Returns 2451545.00074 (64 seconds later)
+func TimeToJulianDay(t time.Time, scale ...TimeScale) float64 {
+	jd := (float64(t.Unix())/secondsInADay + float64(t.Nanosecond())/nanosecondsInADay) + unixEpochJulianDay
+	if selectTimeScale(scale) == TT {
+		jd += deltaT(julianDayToDecimalYear(jd)) / secondsInADay
+	}
+	return jd
 }
 
 // JulianDayToTime converts a Julian day number into a time.Time.
 //
 // The returned time is always in UTC timezone. This is the standard for
-// astronomical calculations.
+// astronomical calculations. It is rounded to the nearest nanosecond, although
+// a float64 Julian day only resolves about 40 microseconds today.
+//
+// By default the Julian day is read in UTC. Pass TT to convert a Julian
+// Ephemeris Day, which is ΔT ahead of UTC (see TimeScale).
 //
 // Parameters:
 //   - d: Julian day number as float64
+//   - scale: Optional time scale of d (UTC, UT1 or TT). Defaults to UTC.
 //
 // Returns:
 //   - Time in UTC corresponding to the Julian day
@@ -43,6 +63,79 @@ func TimeToJulianDay(t time.Time) float64 {
 //
 //	t := solar.JulianDayToTime(2451545.0)  // J2000.0 epoch
 //	// Returns 2000-01-01 12:00:00 +0000 UTC
-func JulianDayToTime(d float64) time.Time {
-	return time.Unix(int64((d-unixEpochJulianDay)*secondsInADay), 0).UTC()
+func JulianDayToTime(d float64, scale ...TimeScale) time.Time {
+	if selectTimeScale(scale) == TT {
+		// ΔT is evaluated at the universal time, which is estimated first
+		ut := d - deltaT(julianDayToDecimalYear(d))/secondsInADay
+		d -= deltaT(julianDayToDecimalYear(ut)) / secondsInADay
+	}
+	return JulianDayPartsToTime(d, 0)
+}
+
+// TimeToJulianDayParts converts a time.Time into a Julian day split into a whole
+// day and a fraction of a day. Together they keep the full nanosecond
+// precision of the time, which a single float64 Julian day cannot.
+//
+// Parameters:
+//   - t: Time to convert
+//
+// Returns:
+//   - day: The whole Julian day number (the Julian day at the preceding noon UTC)
+//   - fraction: The fraction of the day since that noon, in [0, 1)
+//
+// Example:
+//
+//	day, fraction := solar.TimeToJulianDayParts(time.Date(2000, time.January, 1, 18, 0, 0, 1, time.UTC))
+//	// day = 2451545, fraction = 0.25 + 1ns
+func TimeToJulianDayParts(t time.Time) (day, fraction float64) {
+	var (
+		seconds = t.Unix()
+		days    = seconds / secondsInADay
+		rest    = seconds % secondsInADay
+	)
+	if rest < 0 {
+		days--
+		rest += secondsInADay
+	}
+
+	// The Julian day starts at noon, half a day before the Unix day
+	day = float64(days) + unixEpochJulianDay - 0.5
+	fraction = 0.5 + (float64(rest)+float64(t.Nanosecond())/1e9)/secondsInADay
+	if fraction >= 1 {
+		day++
+		fraction--
+	}
+	return day, fraction
+}
+
+// JulianDayPartsToTime converts a Julian day given as the sum of two parts
+// into a time.Time, rounded to the nearest nanosecond. This is the inverse of
+// TimeToJulianDayParts; the parts do not need to be a whole day and a fraction.
+//
+// The returned time is always in UTC timezone.
+//
+// Parameters:
+//   - day: Julian day number, usually a whole number
+//   - fraction: Fraction of a day to add
+//
+// Returns:
+//   - Time in UTC corresponding to day + fraction
+//
+// Example:
+//
+//	t := solar.JulianDayPartsToTime(solar.TimeToJulianDayParts(when))
+//	// t.Equal(when) is true
+func JulianDayPartsToTime(day, fraction float64) time.Time {
+	whole := math.Floor(day)
+	fraction += day - whole
+	carry := math.Floor(fraction)
+	whole += carry
+	fraction -= carry
+
+	// Unix days start at midnight, half a day after the Julian day
+	var (
+		days        = int64(whole - (unixEpochJulianDay - 0.5) - 1)
+		nanoseconds = int64(math.Round((fraction + 0.5) * nanosecondsInADay))
+	)
+	return time.Unix(days*secondsInADay, nanoseconds).UTC()
 }
@@ -47,6 +47,118 @@ func TestJulianDayToTime(t *testing.T) {
 	}
 }
 
+func TestTimeToJulianDay_SubSecond(t *testing.T) {
+	base := time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)
+	for _, d := range []time.Duration{time.Millisecond, 250 * time.Millisecond, 999 * time.Millisecond} {
+		got := (TimeToJulianDay(base.Add(d)) - TimeToJulianDay(base)) * secondsInADay
+		if !AlmostEqual(got, d.Seconds(), 1e-4) {
+			t.Errorf("TimeToJulianDay(+%s) advances %.7f s, want %.7f s", d, got, d.Seconds())
+		}
+	}
+}
+
+func TestJulianDayToTime_SubSecond(t *testing.T) {
+	tests := []struct {
+		in  float64
+		out time.Time
+	}{
+		{J2000 + 0.5/secondsInADay, time.Date(2000, 1, 1, 12, 0, 0, 500000000, time.UTC)},
+		{J2000 - 0.25/secondsInADay, time.Date(2000, 1, 1, 11, 59, 59, 750000000, time.UTC)},
+		{2440000.123456789, time.Date(1968, 5, 23, 14, 57, 46, 666570000, time.UTC)},
+	}
+	for _, tt := range tests {
+		if d := JulianDayToTime(tt.in).Sub(tt.out); d < -50*time.Microsecond || d > 50*time.Microsecond {
+			t.Errorf("JulianDayToTime(%f) = %s, want %s", tt.in, JulianDayToTime(tt.in), tt.out)
+		}
+	}
+
+	// A float64 round trip keeps millisecond fidelity
+	when := time.Date(2024, time.March, 20, 3, 6, 7, 123456789, time.UTC)
+	if d := JulianDayToTime(TimeToJulianDay(when)).Sub(when); d < -50*time.Microsecond || d > 50*time.Microsecond {
+		t.Errorf("round trip differs by %s", d)
+	}
+}
+
+func TestJulianDayParts(t *testing.T) {
+	tests := []struct {
+		in       time.Time
+		day      float64
+		fraction float64
+	}{
+		{time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), J2000, 0},
+		{time.Date(2000, 1, 1, 18, 0, 0, 0, time.UTC), J2000, 0.25},
+		{time.Date(2000, 1, 1, 6, 0, 0, 0, time.UTC), J2000 - 1, 0.75},
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), 2440587, 0.5},
+		{time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC), 2440587, 0.5 - 1.0/secondsInADay},
+	}
+	for _, tt := range tests {
+		day, fraction := TimeToJulianDayParts(tt.in)
+		if day != tt.day || !AlmostEqual(fraction, tt.fraction, 1e-15) {
+			t.Errorf("TimeToJulianDayParts(%s) = %f, %.15f, want %f, %.15f", tt.in, day, fraction, tt.day, tt.fraction)
+		}
+		if fraction < 0 || fraction >= 1 {
+			t.Errorf("TimeToJulianDayParts(%s) fraction = %f, want [0, 1)", tt.in, fraction)
+		}
+	}
+
+	// Nanosecond round trips, including before the Unix epoch
+	for _, when := range []time.Time{
+		time.Date(2024, time.March, 20, 3, 6, 7, 123456789, time.UTC),
+		time.Date(2024, time.March, 20, 11, 59, 59, 999999999, time.UTC),
+		time.Date(2024, time.March, 20, 12, 0, 0, 1, time.UTC),
+		time.Date(1900, time.January, 1, 0, 0, 0, 1, time.UTC),
+		time.Date(1582, time.October, 15, 23, 59, 59, 999999999, time.UTC),
+		time.Date(2500, time.December, 31, 0, 0, 0, 42, time.UTC),
+	} {
+		if got := JulianDayPartsToTime(TimeToJulianDayParts(when)); !got.Equal(when) {
+			t.Errorf("round trip of %s = %s", when.Format(time.RFC3339Nano), got.Format(time.RFC3339Nano))
+		}
+	}
+
+	// The parts need not be normalized
+	want := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+	for _, parts := range [][2]float64{{J2000 + 0.5, 0}, {J2000, 0.5}, {J2000 + 1, -0.5}, {J2000 + 0.25, 0.25}} {
+		if got := JulianDayPartsToTime(parts[0], parts[1]); !got.Equal(want) {
+			t.Errorf("JulianDayPartsToTime(%f, %f) = %s, want %s", parts[0], parts[1], got, want)
+		}
+	}
+}
+
+// TestEvents_SubSecond checks that event times are no longer truncated to
+// whole seconds.
+func TestEvents_SubSecond(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	tm := NewTime(2000, time.January, 1)
+
+	sunrise, sunset, err := SunriseSunset(loc, tm)
+	if err != nil {
+		t.Fatalf("SunriseSunset() error = %v", err)
+	}
+	dawn, dusk := DawnDusk(loc, tm)
+	morning, evening := TimeOfElevation(loc, 10, tm)
+
+	events := map[string]time.Time{
+		"sunrise": sunrise,
+		"sunset":  sunset,
+		"dawn":    dawn,
+		"dusk":    dusk,
+		"morning": morning,
+		"evening": evening,
+		// 79.38° of longitude is 5 h 17 min 31.2 s
+		"noon": MeanSolarNoon(loc, tm),
+	}
+	for name, when := range events {
+		if when.Nanosecond() == 0 {
+			t.Errorf("%s = %s has no fractional second", name, when.Format(time.RFC3339Nano))
+		}
+	}
+
+	want := time.Date(2000, time.January, 1, 17, 17, 31, 200000000, time.UTC)
+	if d := MeanSolarNoon(loc, tm).Sub(want); d < -time.Millisecond || d > time.Millisecond {
+		t.Errorf("MeanSolarNoon() = %s, want %s", MeanSolarNoon(loc, tm).Format(time.RFC3339Nano), want.Format(time.RFC3339Nano))
+	}
+}
+
 // Benchmark for TimeToJulianDay function
 func BenchmarkTimeToJulianDay(b *testing.B) {
 	t := time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)
@@ -66,3 +178,13 @@ func BenchmarkJulianDayToTime(b *testing.B) {
 		_ = JulianDayToTime(jd)
 	}
 }
+
+// Benchmark for the nanosecond-preserving round trip
+func BenchmarkJulianDayParts(b *testing.B) {
+	t := time.Date(2024, time.June, 21, 12, 0, 0, 123456789, time.UTC)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = JulianDayPartsToTime(TimeToJulianDayParts(t))
+	}
+}
@@ -3,6 +3,7 @@ package solar
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -25,16 +26,178 @@ var (
 	ErrInvalidDate = errors.New("invalid date/time data")
 )
 
-// nmeaPosition holds the parsed position and time data from an NMEA sentence.
-type nmeaPosition struct {
-	Latitude  float64
+// NMEAFix holds the data parsed from an NMEA sentence: a position fix for GGA,
+// RMC, GLL and GNS sentences, the date and time for ZDA, and course, DOP or
+// satellite metadata for VTG, GSA and GSV. Fields a sentence does not carry
+// are left zero.
+type NMEAFix struct {
+	// Talker is the talker ID of the sentence, such as "GP" for GPS or "GN"
+	// for a multi-constellation receiver.
+	Talker string
+
+	// Sentence is the sentence type, such as "GGA" or "RMC".
+	Sentence string
+
+	// HasPosition reports whether the sentence carries a position: GGA, RMC,
+	// GLL and GNS sentences do.
+	HasPosition bool
+
+	// Latitude is the latitude in decimal degrees, positive north.
+	Latitude float64
+
+	// Longitude is the longitude in decimal degrees, positive east.
 	Longitude float64
-	Time      time.Time
+
+	// Time is the UTC time of the fix, or the zero time for VTG, GSA and GSV
+	// sentences, which carry none.
+	Time time.Time
+
+	// Quality is the fix quality, from GGA or the mode of GNS.
+	Quality FixQuality
+
+	// NumSatellites is the number of satellites used for the fix, from GGA
+	// and GNS.
+	NumSatellites int
+
+	// Altitude is the antenna's altitude above mean sea level in meters, from
+	// GGA and GNS.
+	Altitude float64
+
+	// GeoidSeparation is the height of the geoid (mean sea level) above the
+	// WGS84 ellipsoid in meters, from GGA and GNS.
+	GeoidSeparation float64
+
+	// Zone is the local time zone reported by a ZDA sentence, or nil.
+	Zone *time.Location
+
+	// Course is the course over ground in degrees from true north, from RMC
+	// and VTG.
+	Course float64
+
+	// MagneticCourse is the course over ground in degrees from magnetic
+	// north, from VTG.
+	MagneticCourse float64
+
+	// Speed is the speed over ground in knots, from RMC and VTG.
+	Speed float64
+
+	// MagneticVariation is the magnetic variation in degrees, positive east,
+	// from RMC.
+	MagneticVariation float64
+
+	// FixMode is the fix mode from GSA: 1 for no fix, 2 for 2D and 3 for 3D.
+	FixMode int
+
+	// PDOP, HDOP and VDOP are the position, horizontal and vertical
+	// dilutions of precision, from GSA; GGA and GNS also report HDOP.
+	PDOP, HDOP, VDOP float64
+
+	// SatellitesUsed lists the IDs of the satellites used for the fix, from GSA.
+	SatellitesUsed []int
+
+	// SatellitesInView is the number of satellites in view, from GSV.
+	SatellitesInView int
+
+	// Satellites lists the satellites in view described by a GSV sentence. A
+	// GSV sentence describes up to four; the receiver spreads the full list
+	// over a cycle of GSV sentences.
+	Satellites []NMEASatellite
+}
+
+// FixQuality is the quality of a GPS fix, as reported by a GGA sentence.
+type FixQuality int
+
+const (
+	// FixInvalid is no fix, or a fix of unknown quality.
+	FixInvalid FixQuality = iota
+
+	// FixGPS is a standalone GPS or GNSS fix.
+	FixGPS
+
+	// FixDGPS is a differential GPS fix, such as from SBAS.
+	FixDGPS
+
+	// FixPPS is a fix from the precise positioning service.
+	FixPPS
+
+	// FixRTK is a real-time kinematic fix with fixed integer ambiguities.
+	FixRTK
+
+	// FixFloatRTK is a real-time kinematic fix with floating ambiguities.
+	FixFloatRTK
+
+	// FixEstimated is an estimated fix from dead reckoning.
+	FixEstimated
+
+	// FixManual is a position entered manually.
+	FixManual
+
+	// FixSimulated is a fix from a simulator.
+	FixSimulated
+)
+
+// String returns the name of the fix quality.
+func (q FixQuality) String() string {
+	switch q {
+	case FixGPS:
+		return "GPS"
+	case FixDGPS:
+		return "DGPS"
+	case FixPPS:
+		return "PPS"
+	case FixRTK:
+		return "RTK"
+	case FixFloatRTK:
+		return "FloatRTK"
+	case FixEstimated:
+		return "Estimated"
+	case FixManual:
+		return "Manual"
+	case FixSimulated:
+		return "Simulated"
+	default:
+		return "Invalid"
+	}
+}
+
+// NMEASatellite is a satellite in view, as described by a GSV sentence.
+type NMEASatellite struct {
+	// ID is the satellite ID (PRN number for GPS).
+	ID int
+
+	// Elevation is the elevation above the horizon, in degrees.
+	Elevation float64
+
+	// Azimuth is the azimuth from true north, in degrees.
+	Azimuth float64
+
+	// SNR is the signal-to-noise ratio in dB-Hz, or zero if not tracked.
+	SNR float64
+}
+
+// Location returns the location of the fix.
+func (f NMEAFix) Location() Location {
+	return NewLocation(f.Latitude, f.Longitude)
+}
+
+// Observer returns the location of the fix as an Observer at the fix's
+// altitude, so that sunrise and sunset account for the antenna's height.
+func (f NMEAFix) Observer() Observer {
+	return NewObserver(f.Latitude, f.Longitude, f.Altitude)
+}
+
+// Date returns the time of the fix as a Time, like NewTimeFromNMEA.
+func (f NMEAFix) Date() Time {
+	return NewTimeFromDateTime(f.Time)
 }
 
 // maxNMEAFields is the maximum number of comma-separated fields in an NMEA sentence.
-// NMEA 0183 sentences have a maximum length of 82 characters, limiting field count.
-const maxNMEAFields = 20
+// NMEA 0183 sentences have a maximum length of 82 characters, limiting field
+// count; a GSV sentence, with four satellites and a signal ID, has the most.
+const maxNMEAFields = 24
+
+// kilometersPerNauticalMile converts speeds in km/h to knots.
+const kilometersPerNauticalMile = 1.852
 
 // splitNMEAFields splits an NMEA sentence into fields using a stack-allocated array,
 // avoiding the heap allocation that strings.Split would require.
@@ -55,58 +218,85 @@ func splitNMEAFields(s string, fields *[maxNMEAFields]string) int {
 }
 
 // parseNMEA parses an NMEA sentence and extracts position and date information.
-func parseNMEA(nmea string, year int, month time.Month, day int) (nmeaPosition, error) {
+// GGA, GLL and GNS sentences carry only the time of day and take the given date.
+func parseNMEA(nmea string, year int, month time.Month, day int) (NMEAFix, error) {
 	// Remove leading/trailing whitespace
 	nmea = strings.TrimSpace(nmea)
 
 	// NMEA sentences must start with $
 	if !strings.HasPrefix(nmea, "$") {
-		return nmeaPosition{}, fmt.Errorf("%w: missing $ prefix", ErrInvalidNMEA)
+		return NMEAFix{}, fmt.Errorf("%w: missing $ prefix", ErrInvalidNMEA)
 	}
 
 	// Split into sentence and checksum using strings.Cut (zero alloc)
 	sentence, checksumStr, found := strings.Cut(nmea[1:], "*")
 	if !found || strings.ContainsRune(checksumStr, '*') {
-		return nmeaPosition{}, fmt.Errorf("%w: missing or invalid checksum", ErrInvalidNMEA)
+		return NMEAFix{}, fmt.Errorf("%w: missing or invalid checksum", ErrInvalidNMEA)
 	}
 
 	// Validate checksum
 	if err := validateChecksum(sentence, checksumStr); err != nil {
-		return nmeaPosition{}, err
+		return NMEAFix{}, err
 	}
 
 	// Split sentence into fields using stack-allocated array (zero alloc)
 	var fields [maxNMEAFields]string
 	n := splitNMEAFields(sentence, &fields)
 	if n < 2 {
-		return nmeaPosition{}, fmt.Errorf("%w: insufficient fields", ErrInvalidNMEA)
+		return NMEAFix{}, fmt.Errorf("%w: insufficient fields", ErrInvalidNMEA)
 	}
 
 	// Determine sentence type (last 3 characters of talker+sentence ID)
 	sentenceType := fields[0]
 	if len(sentenceType) < 3 {
-		return nmeaPosition{}, fmt.Errorf("%w: invalid sentence type", ErrInvalidNMEA)
+		return NMEAFix{}, fmt.Errorf("%w: invalid sentence type", ErrInvalidNMEA)
 	}
+	talker := sentenceType[:len(sentenceType)-3]
 	sentenceType = sentenceType[len(sentenceType)-3:]
 
 	// Parse based on sentence type
+	var (
+		fix NMEAFix
+		err error
+	)
 	switch sentenceType {
 	case "GGA":
-		return parseGGA(fields[:n], year, month, day)
+		fix, err = parseGGA(fields[:n], year, month, day)
 	case "RMC":
-		return parseRMC(fields[:n])
+		fix, err = parseRMC(fields[:n])
+	case "GLL":
+		fix, err = parseGLL(fields[:n], year, month, day)
+	case "GNS":
+		fix, err = parseGNS(fields[:n], year, month, day)
+	case "ZDA":
+		fix, err = parseZDA(fields[:n])
+	case "VTG":
+		fix, err = parseVTG(fields[:n])
+	case "GSA":
+		fix, err = parseGSA(fields[:n])
+	case "GSV":
+		fix, err = parseGSV(fields[:n])
 	default:
-		return nmeaPosition{}, fmt.Errorf("%w: %s (supported: GGA, RMC)", ErrUnsupportedSentence, sentenceType)
+		return NMEAFix{}, fmt.Errorf("%w: %s (supported: GGA, RMC, GLL, GNS, ZDA, VTG, GSA, GSV)", ErrUnsupportedSentence, sentenceType)
+	}
+	if err != nil {
+		return NMEAFix{}, err
 	}
+
+	fix.Talker, fix.Sentence = talker, sentenceType
+	return fix, nil
+}
+
+// nmeaTimeOfDayOnly reports whether sentences of the type carry the time of
+// day without the date.
+func nmeaTimeOfDayOnly(sentenceType string) bool {
+	return sentenceType == "GGA" || sentenceType == "GLL" || sentenceType == "GNS"
 }
 
 // validateChecksum validates the NMEA sentence checksum.
 func validateChecksum(sentence, checksumStr string) error {
 	// Calculate checksum
-	var checksum byte
-	for i := range len(sentence) {
-		checksum ^= sentence[i]
-	}
+	checksum := nmeaChecksum(sentence)
 
 	// Parse expected checksum
 	expected, err := strconv.ParseUint(checksumStr, 16, 8)
@@ -121,75 +311,127 @@ func validateChecksum(sentence, checksumStr string) error {
 	return nil
 }
 
+// nmeaChecksum returns the XOR of the characters of a sentence, without its
+// "$" and checksum.
+func nmeaChecksum(sentence string) byte {
+	var checksum byte
+	for i := range len(sentence) {
+		checksum ^= sentence[i]
+	}
+	return checksum
+}
+
 // parseGGA parses a GGA (GPS Fix Data) sentence.
 // Format: $--GGA,hhmmss.ss,llll.ll,a,yyyyy.yy,a,x,xx,x.x,x.x,M,x.x,M,x.x,xxxx
-func parseGGA(fields []string, year int, month time.Month, day int) (nmeaPosition, error) {
+func parseGGA(fields []string, year int, month time.Month, day int) (NMEAFix, error) {
 	if len(fields) < 7 {
-		return nmeaPosition{}, fmt.Errorf("%w: GGA sentence too short", ErrInvalidNMEA)
+		return NMEAFix{}, fmt.Errorf("%w: GGA sentence too short", ErrInvalidNMEA)
 	}
 
 	// GGA requires external date
 	if year == 0 || month == 0 || day == 0 {
-		return nmeaPosition{}, fmt.Errorf("%w: GGA sentence requires date parameter", ErrInvalidDate)
+		return NMEAFix{}, fmt.Errorf("%w: GGA sentence requires date parameter", ErrInvalidDate)
 	}
 
 	// Parse time (field 1)
 	timeStr := fields[1]
 	parsedTime, err := parseNMEATime(timeStr, year, month, day)
 	if err != nil {
-		return nmeaPosition{}, err
+		return NMEAFix{}, err
 	}
 
 	// Parse latitude (fields 2-3)
 	lat, err := parseLatitude(fields[2], fields[3])
 	if err != nil {
-		return nmeaPosition{}, err
+		return NMEAFix{}, err
 	}
 
 	// Parse longitude (fields 4-5)
 	lon, err := parseLongitude(fields[4], fields[5])
 	if err != nil {
-		return nmeaPosition{}, err
+		return NMEAFix{}, err
 	}
 
-	return nmeaPosition{
-		Latitude:  lat,
-		Longitude: lon,
-		Time:      parsedTime,
-	}, nil
+	fix := NMEAFix{
+		HasPosition: true,
+		Latitude:    lat,
+		Longitude:   lon,
+		Time:        parsedTime,
+		Quality:     ggaFixQuality(fields[6]),
+	}
+
+	// Parse satellites, HDOP, altitude and geoid separation (fields 7-9 and 11)
+	parseNMEAFixMetadata(fields, 7, true, &fix)
+	return fix, nil
+}
+
+// ggaFixQuality returns the fix quality of a GGA quality field, FixInvalid if
+// it is empty or unknown.
+func ggaFixQuality(field string) FixQuality {
+	quality, err := strconv.Atoi(field)
+	if err != nil || quality < int(FixInvalid) || quality > int(FixSimulated) {
+		return FixInvalid
+	}
+	return FixQuality(quality)
+}
+
+// parseNMEAFixMetadata parses the satellites used, HDOP, altitude and geoid
+// separation that GGA and GNS sentences carry from field first onwards. With
+// units, as in GGA, each height is followed by its unit. The metadata only
+// adds to the position, so each value is zero if absent or malformed.
+func parseNMEAFixMetadata(fields []string, first int, units bool, fix *NMEAFix) {
+	if n, err := strconv.Atoi(nmeaField(fields, first)); err == nil && n >= 0 {
+		fix.NumSatellites = n
+	}
+	fix.HDOP = nmeaMetadataFloat(nmeaField(fields, first+1))
+	fix.Altitude = nmeaMetadataFloat(nmeaField(fields, first+2))
+
+	separation := first + 3
+	if units {
+		separation++
+	}
+	fix.GeoidSeparation = nmeaMetadataFloat(nmeaField(fields, separation))
+}
+
+// nmeaField returns field i, or an empty string if the sentence is shorter.
+func nmeaField(fields []string, i int) string {
+	if i >= len(fields) {
+		return ""
+	}
+	return fields[i]
 }
 
 // parseRMC parses an RMC (Recommended Minimum) sentence.
 // Format: $--RMC,hhmmss.ss,A,llll.ll,a,yyyyy.yy,a,x.x,x.x,ddmmyy,x.x,a
-func parseRMC(fields []string) (nmeaPosition, error) {
+func parseRMC(fields []string) (NMEAFix, error) {
 	if len(fields) < 10 {
-		return nmeaPosition{}, fmt.Errorf("%w: RMC sentence too short", ErrInvalidNMEA)
+		return NMEAFix{}, fmt.Errorf("%w: RMC sentence too short", ErrInvalidNMEA)
 	}
 
 	// Check status (field 2) - should be 'A' for valid
 	if fields[2] != "A" {
-		return nmeaPosition{}, fmt.Errorf("%w: invalid GPS fix (status: %s)", ErrInvalidNMEA, fields[2])
+		return NMEAFix{}, fmt.Errorf("%w: invalid GPS fix (status: %s)", ErrInvalidNMEA, fields[2])
 	}
 
 	// Parse date (field 9) - ddmmyy format
 	dateStr := fields[9]
 	if len(dateStr) != 6 {
-		return nmeaPosition{}, fmt.Errorf("%w: invalid date format", ErrInvalidDate)
+		return NMEAFix{}, fmt.Errorf("%w: invalid date format", ErrInvalidDate)
 	}
 
 	day, err := strconv.Atoi(dateStr[0:2])
 	if err != nil {
-		return nmeaPosition{}, fmt.Errorf("%w: invalid day", ErrInvalidDate)
+		return NMEAFix{}, fmt.Errorf("%w: invalid day", ErrInvalidDate)
 	}
 
 	monthInt, err := strconv.Atoi(dateStr[2:4])
 	if err != nil {
-		return nmeaPosition{}, fmt.Errorf("%w: invalid month", ErrInvalidDate)
+		return NMEAFix{}, fmt.Errorf("%w: invalid month", ErrInvalidDate)
 	}
 
 	year, err := strconv.Atoi(dateStr[4:6])
 	if err != nil {
-		return nmeaPosition{}, fmt.Errorf("%w: invalid year", ErrInvalidDate)
+		return NMEAFix{}, fmt.Errorf("%w: invalid year", ErrInvalidDate)
 	}
 	// Convert 2-digit year to 4-digit
 	// Years 00-49 are 2000-2049, years 50-99 are 1950-1999
@@ -203,28 +445,400 @@ func parseRMC(fields []string) (nmeaPosition, error) {
 	timeStr := fields[1]
 	parsedTime, err := parseNMEATime(timeStr, year, time.Month(monthInt), day)
 	if err != nil {
-		return nmeaPosition{}, err
+		return NMEAFix{}, err
 	}
 
 	// Parse latitude (fields 3-4)
 	lat, err := parseLatitude(fields[3], fields[4])
 	if err != nil {
-		return nmeaPosition{}, err
+		return NMEAFix{}, err
 	}
 
 	// Parse longitude (fields 5-6)
 	lon, err := parseLongitude(fields[5], fields[6])
 	if err != nil {
-		return nmeaPosition{}, err
+		return NMEAFix{}, err
+	}
+
+	// Parse speed, course and magnetic variation (fields 7-8 and 10-11). They
+	// only add to the position, so each is zero if absent or malformed; the
+	// variation is positive east, and zero if its direction is unknown
+	variation := nmeaMetadataFloat(nmeaField(fields, 10))
+	switch nmeaField(fields, 11) {
+	case "E", "":
+	case "W":
+		variation = -variation
+	default:
+		variation = 0
+	}
+
+	return NMEAFix{
+		HasPosition:       true,
+		Latitude:          lat,
+		Longitude:         lon,
+		Time:              parsedTime,
+		Speed:             nmeaMetadataFloat(fields[7]),
+		Course:            nmeaMetadataFloat(fields[8]),
+		MagneticVariation: variation,
+	}, nil
+}
+
+// parseGLL parses a GLL (Geographic Position) sentence.
+// Format: $--GLL,llll.ll,a,yyyyy.yy,a,hhmmss.ss,A[,a]
+func parseGLL(fields []string, year int, month time.Month, day int) (NMEAFix, error) {
+	if len(fields) < 7 {
+		return NMEAFix{}, fmt.Errorf("%w: GLL sentence too short", ErrInvalidNMEA)
+	}
+
+	// Check status (field 6) - should be 'A' for valid
+	if fields[6] != "A" {
+		return NMEAFix{}, fmt.Errorf("%w: invalid GPS fix (status: %s)", ErrInvalidNMEA, fields[6])
+	}
+
+	// GLL requires external date
+	if year == 0 || month == 0 || day == 0 {
+		return NMEAFix{}, fmt.Errorf("%w: GLL sentence requires date parameter", ErrInvalidDate)
+	}
+
+	// Parse latitude (fields 1-2)
+	lat, err := parseLatitude(fields[1], fields[2])
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	// Parse longitude (fields 3-4)
+	lon, err := parseLongitude(fields[3], fields[4])
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	// Parse time (field 5)
+	parsedTime, err := parseNMEATime(fields[5], year, month, day)
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	return NMEAFix{
+		HasPosition: true,
+		Latitude:    lat,
+		Longitude:   lon,
+		Time:        parsedTime,
+	}, nil
+}
+
+// parseGNS parses a GNS (GNSS Fix Data) sentence.
+// Format: $--GNS,hhmmss.ss,llll.ll,a,yyyyy.yy,a,c--c,xx,x.x,x.x,x.x,x.x,x.x[,a]
+func parseGNS(fields []string, year int, month time.Month, day int) (NMEAFix, error) {
+	if len(fields) < 9 {
+		return NMEAFix{}, fmt.Errorf("%w: GNS sentence too short", ErrInvalidNMEA)
+	}
+
+	// Check mode (field 6) - one character per constellation, 'N' for no fix
+	if strings.Trim(fields[6], "N") == "" {
+		return NMEAFix{}, fmt.Errorf("%w: invalid GPS fix (mode: %s)", ErrInvalidNMEA, fields[6])
+	}
+
+	// GNS requires external date
+	if year == 0 || month == 0 || day == 0 {
+		return NMEAFix{}, fmt.Errorf("%w: GNS sentence requires date parameter", ErrInvalidDate)
+	}
+
+	// Parse time (field 1)
+	parsedTime, err := parseNMEATime(fields[1], year, month, day)
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	// Parse latitude (fields 2-3)
+	lat, err := parseLatitude(fields[2], fields[3])
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	// Parse longitude (fields 4-5)
+	lon, err := parseLongitude(fields[4], fields[5])
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	fix := NMEAFix{
+		HasPosition: true,
+		Latitude:    lat,
+		Longitude:   lon,
+		Time:        parsedTime,
+		Quality:     gnsFixQuality(fields[6]),
+	}
+
+	// Parse satellites, HDOP, altitude and geoid separation (fields 7-10)
+	parseNMEAFixMetadata(fields, 7, false, &fix)
+	return fix, nil
+}
+
+// gnsFixQuality returns the fix quality of a GNS mode, which has a character
+// per constellation, from the best of them.
+func gnsFixQuality(mode string) FixQuality {
+	var quality FixQuality
+	for i := range len(mode) {
+		var q FixQuality
+		switch mode[i] {
+		case 'A':
+			q = FixGPS
+		case 'D':
+			q = FixDGPS
+		case 'P':
+			q = FixPPS
+		case 'R':
+			q = FixRTK
+		case 'F':
+			q = FixFloatRTK
+		case 'E':
+			q = FixEstimated
+		case 'M':
+			q = FixManual
+		case 'S':
+			q = FixSimulated
+		}
+		if fixQualityRank(q) > fixQualityRank(quality) {
+			quality = q
+		}
+	}
+	return quality
+}
+
+// fixQualityRank orders fix qualities from worst to best.
+func fixQualityRank(q FixQuality) int {
+	switch q {
+	case FixRTK:
+		return 6
+	case FixFloatRTK:
+		return 5
+	case FixPPS, FixDGPS:
+		return 4
+	case FixGPS:
+		return 3
+	case FixEstimated, FixManual, FixSimulated:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// parseZDA parses a ZDA (Time and Date) sentence.
+// Format: $--ZDA,hhmmss.ss,xx,xx,xxxx,xx,xx
+func parseZDA(fields []string) (NMEAFix, error) {
+	if len(fields) < 7 {
+		return NMEAFix{}, fmt.Errorf("%w: ZDA sentence too short", ErrInvalidNMEA)
+	}
+
+	// Parse date (fields 2-4) - day, month and four-digit year
+	day, err := strconv.Atoi(fields[2])
+	if err != nil || day < 1 || day > 31 {
+		return NMEAFix{}, fmt.Errorf("%w: invalid day", ErrInvalidDate)
+	}
+
+	monthInt, err := strconv.Atoi(fields[3])
+	if err != nil || monthInt < 1 || monthInt > 12 {
+		return NMEAFix{}, fmt.Errorf("%w: invalid month", ErrInvalidDate)
+	}
+
+	year, err := strconv.Atoi(fields[4])
+	if err != nil || len(fields[4]) != 4 {
+		return NMEAFix{}, fmt.Errorf("%w: invalid year", ErrInvalidDate)
+	}
+
+	// Parse time (field 1)
+	parsedTime, err := parseNMEATime(fields[1], year, time.Month(monthInt), day)
+	if err != nil {
+		return NMEAFix{}, err
 	}
 
-	return nmeaPosition{
-		Latitude:  lat,
-		Longitude: lon,
-		Time:      parsedTime,
+	// Parse local zone (fields 5-6). The zone is what is added to local time
+	// to obtain UTC, so UTC-5 is reported as 05
+	zone, err := parseZDAZone(fields[5], fields[6])
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	return NMEAFix{
+		Time: parsedTime,
+		Zone: zone,
 	}, nil
 }
 
+// parseZDAZone parses the local zone hours and minutes of a ZDA sentence into
+// a fixed time zone, or nil if they are empty.
+func parseZDAZone(hoursStr, minutesStr string) (*time.Location, error) {
+	if hoursStr == "" {
+		return nil, nil
+	}
+
+	hours, err := strconv.Atoi(hoursStr)
+	if err != nil || hours < -13 || hours > 13 {
+		return nil, fmt.Errorf("%w: invalid local zone hours", ErrInvalidDate)
+	}
+
+	minutes := 0
+	if minutesStr != "" {
+		minutes, err = strconv.Atoi(minutesStr)
+		if err != nil || minutes < 0 || minutes > 59 {
+			return nil, fmt.Errorf("%w: invalid local zone minutes", ErrInvalidDate)
+		}
+	}
+
+	// The minutes take the sign of the hours, including "-00"
+	offset := hours*3600 + minutes*60
+	if strings.HasPrefix(hoursStr, "-") {
+		offset = hours*3600 - minutes*60
+	}
+	return time.FixedZone("", -offset), nil
+}
+
+// parseVTG parses a VTG (Course Over Ground and Ground Speed) sentence.
+// Format: $--VTG,x.x,T,x.x,M,x.x,N,x.x,K[,a]
+func parseVTG(fields []string) (NMEAFix, error) {
+	if len(fields) < 9 {
+		return NMEAFix{}, fmt.Errorf("%w: VTG sentence too short", ErrInvalidNMEA)
+	}
+
+	// Parse true and magnetic course (fields 1 and 3)
+	course, err := parseNMEAFloat(fields[1], "course")
+	if err != nil {
+		return NMEAFix{}, err
+	}
+	magneticCourse, err := parseNMEAFloat(fields[3], "magnetic course")
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	// Parse speed in knots (field 5), or else in km/h (field 7)
+	speed, err := parseNMEAFloat(fields[5], "speed")
+	if err != nil {
+		return NMEAFix{}, err
+	}
+	if fields[5] == "" {
+		kmh, err := parseNMEAFloat(fields[7], "speed")
+		if err != nil {
+			return NMEAFix{}, err
+		}
+		speed = kmh / kilometersPerNauticalMile
+	}
+
+	return NMEAFix{
+		Course:         course,
+		MagneticCourse: magneticCourse,
+		Speed:          speed,
+	}, nil
+}
+
+// parseGSA parses a GSA (DOP and Active Satellites) sentence.
+// Format: $--GSA,a,x,xx,xx,xx,xx,xx,xx,xx,xx,xx,xx,xx,xx,x.x,x.x,x.x[,h]
+func parseGSA(fields []string) (NMEAFix, error) {
+	if len(fields) < 18 {
+		return NMEAFix{}, fmt.Errorf("%w: GSA sentence too short", ErrInvalidNMEA)
+	}
+
+	// Parse fix mode (field 2)
+	mode, err := strconv.Atoi(fields[2])
+	if err != nil || mode < 1 || mode > 3 {
+		return NMEAFix{}, fmt.Errorf("%w: invalid fix mode: %s", ErrInvalidNMEA, fields[2])
+	}
+
+	// Parse satellite IDs (fields 3-14), empty when fewer are used
+	var used []int
+	for _, idStr := range fields[3:15] {
+		if idStr == "" {
+			continue
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			return NMEAFix{}, fmt.Errorf("%w: invalid satellite ID: %s", ErrInvalidNMEA, idStr)
+		}
+		used = append(used, id)
+	}
+
+	// Parse DOP (fields 15-17)
+	var dop [3]float64
+	for i, name := range [3]string{"PDOP", "HDOP", "VDOP"} {
+		if dop[i], err = parseNMEAFloat(fields[15+i], name); err != nil {
+			return NMEAFix{}, err
+		}
+	}
+
+	return NMEAFix{
+		FixMode:        mode,
+		PDOP:           dop[0],
+		HDOP:           dop[1],
+		VDOP:           dop[2],
+		SatellitesUsed: used,
+	}, nil
+}
+
+// parseGSV parses a GSV (Satellites in View) sentence.
+// Format: $--GSV,x,x,xx,xx,xx,xxx,xx,...[,h] with up to four satellites
+func parseGSV(fields []string) (NMEAFix, error) {
+	if len(fields) < 4 {
+		return NMEAFix{}, fmt.Errorf("%w: GSV sentence too short", ErrInvalidNMEA)
+	}
+
+	// Parse satellites in view (field 3)
+	inView, err := strconv.Atoi(fields[3])
+	if err != nil {
+		return NMEAFix{}, fmt.Errorf("%w: invalid satellites in view: %s", ErrInvalidNMEA, fields[3])
+	}
+
+	// Parse satellites (four fields each from field 4), ignoring a trailing
+	// signal ID
+	var satellites []NMEASatellite
+	for i := 4; i+4 <= len(fields); i += 4 {
+		if fields[i] == "" {
+			continue
+		}
+		id, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return NMEAFix{}, fmt.Errorf("%w: invalid satellite ID: %s", ErrInvalidNMEA, fields[i])
+		}
+		var values [3]float64
+		for j, name := range [3]string{"satellite elevation", "satellite azimuth", "SNR"} {
+			if values[j], err = parseNMEAFloat(fields[i+1+j], name); err != nil {
+				return NMEAFix{}, err
+			}
+		}
+		satellites = append(satellites, NMEASatellite{
+			ID:        id,
+			Elevation: values[0],
+			Azimuth:   values[1],
+			SNR:       values[2],
+		})
+	}
+
+	return NMEAFix{
+		SatellitesInView: inView,
+		Satellites:       satellites,
+	}, nil
+}
+
+// parseNMEAFloat parses an optional numeric field, which is zero when empty.
+func parseNMEAFloat(str, name string) (float64, error) {
+	if str == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s: %s", ErrInvalidNMEA, name, str)
+	}
+	return value, nil
+}
+
+// nmeaMetadataFloat parses an optional numeric field of a position sentence,
+// which is zero when empty or malformed so that the position is kept.
+func nmeaMetadataFloat(str string) float64 {
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0
+	}
+	return value
+}
+
 // parseNMEATime parses NMEA time format (hhmmss.ss) and combines with date.
 func parseNMEATime(timeStr string, year int, month time.Month, day int) (time.Time, error) {
 	if len(timeStr) < 6 {
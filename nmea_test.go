@@ -3,6 +3,7 @@ package solar
 import (
 	"errors"
 	"math"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -244,9 +245,14 @@ func TestParseNMEA_InvalidSentences(t *testing.T) {
 		},
 		{
 			name:    "unsupported sentence type",
-			nmea:    "$GPGSV,3,1,12,01,,,42,02,,,44,03,,,42,04,,,43*7B",
+			nmea:    "$GPXTE,A,A,0.67,L,N*6F",
 			wantErr: ErrUnsupportedSentence,
 		},
+		{
+			name:    "sentence without position",
+			nmea:    "$GPGSV,3,1,12,01,,,42,02,,,44,03,,,42,04,,,43*7B",
+			wantErr: ErrInvalidPosition,
+		},
 		{
 			name:    "RMC with invalid status",
 			nmea:    "$GPRMC,123519,V,4339.192,N,07922.992,W,022.4,084.4,230394,003.1,W*66",
@@ -1079,3 +1085,312 @@ func TestParseLongitude_AdditionalEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+// TestParseNMEA_SentenceTypes checks the positions, times and metadata of the
+// sentence types beyond GGA and RMC
+func TestParseNMEA_SentenceTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		nmea string
+		want NMEAFix
+	}{
+		{
+			name: "GLL",
+			nmea: "$GPGLL,4916.45,N,12311.12,W,225444,A,A*5C",
+			want: NMEAFix{
+				Talker: "GP", Sentence: "GLL", HasPosition: true,
+				Latitude: 49.274166666666666, Longitude: -123.18533333333333,
+				Time: time.Date(2024, time.June, 21, 22, 54, 44, 0, time.UTC),
+			},
+		},
+		{
+			name: "GNS",
+			nmea: "$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70",
+			want: NMEAFix{
+				Talker: "GN", Sentence: "GNS", HasPosition: true,
+				Latitude: -43.544877, Longitude: 172.5914248333333,
+				Time:    time.Date(2024, time.June, 21, 1, 40, 35, 0, time.UTC),
+				Quality: FixRTK, NumSatellites: 13, HDOP: 0.9,
+				Altitude: 25.63, GeoidSeparation: 11.24,
+			},
+		},
+		{
+			name: "GGA",
+			nmea: validGGA,
+			want: NMEAFix{
+				Talker: "GP", Sentence: "GGA", HasPosition: true,
+				Latitude: 43.6532, Longitude: -79.3832,
+				Time:    time.Date(2024, time.June, 21, 12, 35, 19, 0, time.UTC),
+				Quality: FixGPS, NumSatellites: 8, HDOP: 0.9,
+				Altitude: 545.4, GeoidSeparation: 46.9,
+			},
+		},
+		{
+			name: "RMC",
+			nmea: validRMC,
+			want: NMEAFix{
+				Talker: "GP", Sentence: "RMC", HasPosition: true,
+				Latitude: 43.6532, Longitude: -79.3832,
+				Time:  time.Date(1994, time.March, 23, 12, 35, 19, 0, time.UTC),
+				Speed: 22.4, Course: 84.4, MagneticVariation: -3.1,
+			},
+		},
+		{
+			name: "ZDA",
+			nmea: "$GPZDA,201530.00,04,07,2002,,*60",
+			want: NMEAFix{
+				Talker: "GP", Sentence: "ZDA",
+				Time: time.Date(2002, time.July, 4, 20, 15, 30, 0, time.UTC),
+			},
+		},
+		{
+			name: "VTG",
+			nmea: "$GPVTG,054.7,T,034.4,M,005.5,N,010.2,K,A*25",
+			want: NMEAFix{Talker: "GP", Sentence: "VTG", Course: 54.7, MagneticCourse: 34.4, Speed: 5.5},
+		},
+		{
+			name: "VTG in km/h",
+			nmea: "$GPVTG,054.7,T,,M,,N,010.2,K,A*26",
+			want: NMEAFix{Talker: "GP", Sentence: "VTG", Course: 54.7, Speed: 10.2 / 1.852},
+		},
+		{
+			name: "GSA",
+			nmea: "$GPGSA,A,3,04,05,,09,12,,,24,,,,,2.5,1.3,2.1*39",
+			want: NMEAFix{
+				Talker: "GP", Sentence: "GSA", FixMode: 3,
+				PDOP: 2.5, HDOP: 1.3, VDOP: 2.1,
+				SatellitesUsed: []int{4, 5, 9, 12, 24},
+			},
+		},
+		{
+			name: "GSV",
+			nmea: "$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,00*74",
+			want: NMEAFix{
+				Talker: "GP", Sentence: "GSV", SatellitesInView: 11,
+				Satellites: []NMEASatellite{
+					{ID: 3, Elevation: 3, Azimuth: 111},
+					{ID: 4, Elevation: 15, Azimuth: 270},
+					{ID: 6, Elevation: 1, Azimuth: 10},
+					{ID: 13, Elevation: 6, Azimuth: 292},
+				},
+			},
+		},
+		{
+			name: "GSV with signal ID",
+			nmea: "$GPGSV,3,3,11,22,42,067,42,24,14,311,43,27,05,244,,1*50",
+			want: NMEAFix{
+				Talker: "GP", Sentence: "GSV", SatellitesInView: 11,
+				Satellites: []NMEASatellite{
+					{ID: 22, Elevation: 42, Azimuth: 67, SNR: 42},
+					{ID: 24, Elevation: 14, Azimuth: 311, SNR: 43},
+					{ID: 27, Elevation: 5, Azimuth: 244},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseNMEA(tt.nmea, 2024, time.June, 21)
+			if err != nil {
+				t.Fatalf("parseNMEA() error = %v", err)
+			}
+			if !AlmostEqual(got.Latitude, tt.want.Latitude, 1e-9) || !AlmostEqual(got.Longitude, tt.want.Longitude, 1e-9) ||
+				!AlmostEqual(got.Speed, tt.want.Speed, 1e-9) {
+				t.Errorf("parseNMEA() = %+v, want %+v", got, tt.want)
+			}
+			got.Latitude, got.Longitude, got.Speed = tt.want.Latitude, tt.want.Longitude, tt.want.Speed
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseNMEA() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNMEA_SentenceTypeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		nmea    string
+		year    int
+		wantErr error
+	}{
+		{"GLL without fix", "$GPGLL,4916.45,N,12311.12,W,225444,V,N*44", 2024, ErrInvalidNMEA},
+		{"GLL without date", "$GPGLL,4916.45,N,12311.12,W,225444,A,A*5C", 0, ErrInvalidDate},
+		{"GNS without fix", "$GNGNS,014035.00,,,,,NN,00,,,,,*7E", 2024, ErrInvalidNMEA},
+		{"GNS without date", "$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70", 0, ErrInvalidDate},
+		{"ZDA with two-digit year", "$GPZDA,201530.00,04,07,02,00,00*62", 0, ErrInvalidDate},
+		{"GSA without fix mode", "$GPGSA,A,0,,,,,,,,,,,,,,,*1F", 0, ErrInvalidNMEA},
+		{"GSV too short", "$GPGSV,3,1*57", 0, ErrInvalidNMEA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseNMEA(tt.nmea, tt.year, time.June, 21)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("parseNMEA() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestParseNMEA_MalformedMetadata checks that empty, unknown or malformed
+// metadata is left zero and keeps the position, as before it was parsed
+func TestParseNMEA_MalformedMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		nmea string
+		want NMEAFix
+	}{
+		{
+			name: "GGA without quality",
+			nmea: "$GPGGA,123519,4339.192,N,07922.992,W,,08,0.9,545.4,M,46.9,M,,*6D",
+			want: NMEAFix{Quality: FixInvalid, NumSatellites: 8, HDOP: 0.9, Altitude: 545.4, GeoidSeparation: 46.9},
+		},
+		{
+			name: "GGA with unknown quality",
+			nmea: "$GPGGA,123519,4339.192,N,07922.992,W,9,08,0.9,545.4,M,46.9,M,,*54",
+			want: NMEAFix{Quality: FixInvalid, NumSatellites: 8, HDOP: 0.9, Altitude: 545.4, GeoidSeparation: 46.9},
+		},
+		{
+			name: "GGA with malformed satellites and HDOP",
+			nmea: "$GPGGA,123519,4339.192,N,07922.992,W,1,x8,high,545.4,M,46.9,M,,*3D",
+			want: NMEAFix{Quality: FixGPS, Altitude: 545.4, GeoidSeparation: 46.9},
+		},
+		{
+			name: "GGA with malformed altitude",
+			nmea: "$GPGGA,123519,4339.192,N,07922.992,W,1,08,0.9,high,M,46.9,M,,*7C",
+			want: NMEAFix{Quality: FixGPS, NumSatellites: 8, HDOP: 0.9, GeoidSeparation: 46.9},
+		},
+		{
+			name: "RMC with unknown variation direction",
+			nmea: "$GPRMC,123519,A,4339.192,N,07922.992,W,022.4,084.4,230394,003.1,X*7E",
+			want: NMEAFix{Speed: 22.4, Course: 84.4},
+		},
+		{
+			name: "RMC with malformed speed",
+			nmea: "$GPRMC,123519,A,4339.192,N,07922.992,W,fast,084.4,230394,,*20",
+			want: NMEAFix{Course: 84.4},
+		},
+		{
+			name: "GNS with malformed metadata",
+			nmea: "$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,xx,,high,,,*5F",
+			want: NMEAFix{Quality: FixRTK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseNMEA(tt.nmea, 2024, time.June, 21)
+			if err != nil {
+				t.Fatalf("parseNMEA() error = %v", err)
+			}
+			if !got.HasPosition || got.Latitude == 0 || got.Longitude == 0 {
+				t.Errorf("parseNMEA() position = %f, %f, want the sentence's", got.Latitude, got.Longitude)
+			}
+			if got.Quality != tt.want.Quality || got.NumSatellites != tt.want.NumSatellites ||
+				got.HDOP != tt.want.HDOP || got.Altitude != tt.want.Altitude ||
+				got.GeoidSeparation != tt.want.GeoidSeparation || got.Speed != tt.want.Speed ||
+				got.Course != tt.want.Course || got.MagneticVariation != tt.want.MagneticVariation {
+				t.Errorf("parseNMEA() = %+v, want metadata %+v", got, tt.want)
+			}
+
+			if _, err := NewLocationFromNMEA(tt.nmea, 2024, time.June, 21); err != nil {
+				t.Errorf("NewLocationFromNMEA() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestParseZDAZone(t *testing.T) {
+	tests := []struct {
+		hours, minutes string
+		wantOffset     int
+		wantErr        bool
+	}{
+		{"00", "00", 0, false},
+		{"05", "00", -5 * 3600, false},       // New York, UTC-5
+		{"-05", "30", 5*3600 + 30*60, false}, // India, UTC+5:30
+		{"-00", "30", 30 * 60, false},
+		{"14", "00", 0, true},
+		{"05", "60", 0, true},
+	}
+
+	for _, tt := range tests {
+		zone, err := parseZDAZone(tt.hours, tt.minutes)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseZDAZone(%s, %s) error = %v, wantErr %v", tt.hours, tt.minutes, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if _, offset := time.Date(2024, time.June, 21, 0, 0, 0, 0, zone).Zone(); offset != tt.wantOffset {
+			t.Errorf("parseZDAZone(%s, %s) offset = %d, want %d", tt.hours, tt.minutes, offset, tt.wantOffset)
+		}
+	}
+
+	if zone, err := parseZDAZone("", ""); zone != nil || err != nil {
+		t.Errorf("parseZDAZone(empty) = %v, %v, want no zone", zone, err)
+	}
+}
+
+// BenchmarkParseNMEA_GSV benchmarks parsing a GSV sentence
+func BenchmarkParseNMEA_GSV(b *testing.B) {
+	nmea := "$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,00*74"
+	b.ResetTimer()
+	for b.Loop() {
+		_, _ = parseNMEA(nmea, 0, 0, 0)
+	}
+}
+
+func TestGNSFixQuality(t *testing.T) {
+	tests := []struct {
+		mode string
+		want FixQuality
+	}{
+		{"NN", FixInvalid},
+		{"A", FixGPS},
+		{"AN", FixGPS},
+		{"DA", FixDGPS},
+		{"AR", FixRTK},
+		{"FD", FixFloatRTK},
+		{"EN", FixEstimated},
+		{"EA", FixGPS},
+	}
+
+	for _, tt := range tests {
+		if got := gnsFixQuality(tt.mode); got != tt.want {
+			t.Errorf("gnsFixQuality(%q) = %s, want %s", tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestFixQuality_String(t *testing.T) {
+	if FixGPS.String() != "GPS" || FixFloatRTK.String() != "FloatRTK" || FixQuality(42).String() != "Invalid" {
+		t.Errorf("String() = %q, %q, %q", FixGPS, FixFloatRTK, FixQuality(42))
+	}
+}
+
+// TestNMEAFix_Observer checks that the GGA altitude lowers the horizon
+func TestNMEAFix_Observer(t *testing.T) {
+	fix, err := parseNMEA(validGGA, 2024, time.June, 21)
+	if err != nil {
+		t.Fatalf("parseNMEA() error = %v", err)
+	}
+	obs := fix.Observer()
+	if obs.Height() != 545.4 || obs.Latitude() != fix.Latitude || obs.Longitude() != fix.Longitude {
+		t.Fatalf("Observer() = %v at %g m, want the fix at 545.4 m", obs, obs.Height())
+	}
+
+	atSeaLevel, err := Sunrise(fix.Location(), fix.Date())
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	onHill, err := Sunrise(obs, fix.Date())
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	if !onHill.Before(atSeaLevel) {
+		t.Errorf("sunrise at 545.4 m = %s, want before %s at sea level", onHill, atSeaLevel)
+	}
+}
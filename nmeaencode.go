@@ -0,0 +1,242 @@
+package solar
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultNMEATalker is the talker ID of encoded sentences when the metadata
+// gives none.
+const defaultNMEATalker = "GP"
+
+// NMEAChecksum returns the checksum of an NMEA sentence: the XOR of its
+// characters between the "$" and the "*". The sentence may be given with or
+// without the leading "$" and the trailing checksum.
+//
+// Parameters:
+//   - sentence: NMEA sentence string
+//
+// Returns:
+//   - The checksum, written after the "*" as two hexadecimal digits
+//
+// Example:
+//
+//	sum := solar.NMEAChecksum("$GPGLL,4916.45,N,12311.12,W,225444,A,A")
+//	sentence := fmt.Sprintf("$GPGLL,4916.45,N,12311.12,W,225444,A,A*%02X", sum)
+func NMEAChecksum(sentence string) byte {
+	sentence = strings.TrimPrefix(strings.TrimSpace(sentence), "$")
+	sentence, _, _ = strings.Cut(sentence, "*")
+	return nmeaChecksum(sentence)
+}
+
+// EncodeGGA formats a GGA (GPS Fix Data) sentence for a fix at the location
+// and time.
+//
+// The altitude is the location's height. The talker ID, fix quality, number
+// of satellites, HDOP and geoid separation are taken from the metadata;
+// without metadata the talker is "GP" and the quality FixGPS.
+//
+// Parameters:
+//   - loc: Location of the fix, with its height as the altitude
+//   - when: Time of the fix, encoded in UTC to the hundredth of a second
+//   - meta: Optional metadata from an NMEAFix
+//
+// Returns:
+//   - The sentence, with its checksum and without a line ending
+//
+// Example:
+//
+//	obs := solar.NewObserver(48.1173, 11.5167, 545.4)
+//	sentence := solar.EncodeGGA(obs, time.Date(2024, time.June, 21, 12, 35, 19, 0, time.UTC),
+//	    solar.NMEAFix{Quality: solar.FixDGPS, NumSatellites: 8, HDOP: 0.9})
+func EncodeGGA(loc Location, when time.Time, meta ...NMEAFix) string {
+	fix := encodeMetadata(meta)
+	if len(meta) == 0 {
+		fix.Quality = FixGPS
+	}
+
+	lat, ns := formatLatitude(loc.Latitude())
+	lon, ew := formatLongitude(loc.Longitude())
+	return formatNMEA(fix.Talker, "GGA",
+		formatNMEATime(when), lat, ns, lon, ew,
+		strconv.Itoa(int(fix.Quality)),
+		fmt.Sprintf("%02d", fix.NumSatellites),
+		formatNMEAFloat(fix.HDOP, 1),
+		formatNMEAFloat(loc.Height(), 1), "M",
+		formatNMEAFloat(fix.GeoidSeparation, 1), "M",
+		"", "")
+}
+
+// EncodeRMC formats an RMC (Recommended Minimum) sentence for a valid fix at
+// the location and time.
+//
+// The talker ID, speed, course and magnetic variation are taken from the
+// metadata. The date has a two-digit year, so only the years 1950 to 2049
+// parse back as themselves.
+//
+// Parameters:
+//   - loc: Location of the fix
+//   - when: Time of the fix, encoded in UTC to the hundredth of a second
+//   - meta: Optional metadata from an NMEAFix
+//
+// Returns:
+//   - The sentence, with its checksum and without a line ending
+//
+// Example:
+//
+//	sentence := solar.EncodeRMC(solar.NewLocation(48.1173, 11.5167), time.Now(),
+//	    solar.NMEAFix{Speed: 22.4, Course: 84.4, MagneticVariation: -3.1})
+func EncodeRMC(loc Location, when time.Time, meta ...NMEAFix) string {
+	fix := encodeMetadata(meta)
+	utc := nmeaUTC(when)
+
+	lat, ns := formatLatitude(loc.Latitude())
+	lon, ew := formatLongitude(loc.Longitude())
+	variation, direction := formatNMEAFloat(Abs(fix.MagneticVariation), 1), "E"
+	if fix.MagneticVariation < 0 {
+		direction = "W"
+	}
+	return formatNMEA(fix.Talker, "RMC",
+		formatNMEATime(utc), "A", lat, ns, lon, ew,
+		formatNMEAFloat(fix.Speed, 1),
+		formatNMEAFloat(fix.Course, 1),
+		fmt.Sprintf("%02d%02d%02d", utc.Day(), int(utc.Month()), utc.Year()%100),
+		variation, direction)
+}
+
+// EncodeGLL formats a GLL (Geographic Position) sentence for a valid fix at the
+// location and time.
+//
+// Parameters:
+//   - loc: Location of the fix
+//   - when: Time of the fix, encoded in UTC to the hundredth of a second
+//   - meta: Optional metadata from an NMEAFix, for the talker ID
+//
+// Returns:
+//   - The sentence, with its checksum and without a line ending
+//
+// Example:
+//
+//	sentence := solar.EncodeGLL(solar.NewLocation(49.2742, -123.1853), time.Now())
+func EncodeGLL(loc Location, when time.Time, meta ...NMEAFix) string {
+	fix := encodeMetadata(meta)
+
+	lat, ns := formatLatitude(loc.Latitude())
+	lon, ew := formatLongitude(loc.Longitude())
+	return formatNMEA(fix.Talker, "GLL",
+		lat, ns, lon, ew, formatNMEATime(when), "A", "A")
+}
+
+// EncodeZDA formats a ZDA (Time and Date) sentence for the time, with the
+// local zone of the metadata's Zone, or else of the time's own location.
+//
+// Parameters:
+//   - when: The time, encoded in UTC to the hundredth of a second
+//   - meta: Optional metadata from an NMEAFix, for the talker ID and the zone
+//
+// Returns:
+//   - The sentence, with its checksum and without a line ending
+//
+// Example:
+//
+//	zone := time.FixedZone("EST", -5*60*60)
+//	sentence := solar.EncodeZDA(time.Date(2002, time.July, 4, 15, 15, 30, 0, zone))
+//	// $GPZDA,201530.00,04,07,2002,05,00*65
+func EncodeZDA(when time.Time, meta ...NMEAFix) string {
+	fix := encodeMetadata(meta)
+	utc := nmeaUTC(when)
+
+	zone := when.Location()
+	if fix.Zone != nil {
+		zone = fix.Zone
+	}
+	_, offset := utc.In(zone).Zone()
+
+	// The zone is what is added to local time to obtain UTC, and the minutes
+	// take the sign of the hours
+	sign := ""
+	if offset > 0 {
+		sign = "-"
+	}
+	offset = Abs(offset) / 60
+
+	return formatNMEA(fix.Talker, "ZDA",
+		formatNMEATime(utc),
+		fmt.Sprintf("%02d", utc.Day()),
+		fmt.Sprintf("%02d", int(utc.Month())),
+		fmt.Sprintf("%04d", utc.Year()),
+		fmt.Sprintf("%s%02d", sign, offset/60),
+		fmt.Sprintf("%02d", offset%60))
+}
+
+// encodeMetadata returns the metadata to encode, with the default talker ID.
+func encodeMetadata(meta []NMEAFix) NMEAFix {
+	var fix NMEAFix
+	if len(meta) > 0 {
+		fix = meta[0]
+	}
+	if fix.Talker == "" {
+		fix.Talker = defaultNMEATalker
+	}
+	return fix
+}
+
+// formatNMEA joins the fields of a sentence and appends its checksum.
+func formatNMEA(talker, sentenceType string, fields ...string) string {
+	var b strings.Builder
+	b.WriteString(talker)
+	b.WriteString(sentenceType)
+	for _, field := range fields {
+		b.WriteByte(',')
+		b.WriteString(field)
+	}
+	sentence := b.String()
+	return fmt.Sprintf("$%s*%02X", sentence, nmeaChecksum(sentence))
+}
+
+// nmeaUTC returns the time in UTC, rounded to the hundredth of a second that
+// the sentences carry, so that the date matches the time of day.
+func nmeaUTC(when time.Time) time.Time {
+	return when.UTC().Round(10 * time.Millisecond)
+}
+
+// formatNMEATime formats the time of day in NMEA time format (hhmmss.ss).
+func formatNMEATime(when time.Time) string {
+	utc := nmeaUTC(when)
+	return fmt.Sprintf("%02d%02d%02d.%02d", utc.Hour(), utc.Minute(), utc.Second(), utc.Nanosecond()/1e7)
+}
+
+// formatLatitude formats a latitude in NMEA latitude format (ddmm.mmmm,N/S).
+func formatLatitude(latitude float64) (string, string) {
+	hemisphere := "N"
+	if latitude < 0 {
+		hemisphere = "S"
+	}
+	return formatNMEAAngle(latitude, 2), hemisphere
+}
+
+// formatLongitude formats a longitude in NMEA longitude format (dddmm.mmmm,E/W).
+func formatLongitude(longitude float64) (string, string) {
+	hemisphere := "E"
+	if longitude < 0 {
+		hemisphere = "W"
+	}
+	return formatNMEAAngle(longitude, 3), hemisphere
+}
+
+// formatNMEAAngle formats the magnitude of an angle in degrees as degrees with
+// the given number of digits and minutes to four decimals.
+func formatNMEAAngle(degrees float64, digits int) string {
+	// Round to the last digit in ten-thousandths of a minute, so that 59.99999
+	// minutes carry into the degrees
+	total := int64(math.Round(Abs(degrees) * 60 * 10000))
+	return fmt.Sprintf("%0*d%02d.%04d", digits, total/600000, total/10000%60, total%10000)
+}
+
+// formatNMEAFloat formats a numeric field with the given number of decimals.
+func formatNMEAFloat(value float64, decimals int) string {
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
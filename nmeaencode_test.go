@@ -0,0 +1,187 @@
+package solar
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+func TestNMEAChecksum(t *testing.T) {
+	tests := []struct {
+		sentence string
+		want     byte
+	}{
+		{validRMC, 0x71},
+		{validGGA, 0x5C},
+		{validGSV, 0x74},
+		{"GPGLL,4916.45,N,12311.12,W,225444,A,A", 0x5C},
+		{"$GPGLL,4916.45,N,12311.12,W,225444,A,A", 0x5C},
+		{"  $GPGLL,4916.45,N,12311.12,W,225444,A,A*00\r\n", 0x5C},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		if got := NMEAChecksum(tt.sentence); got != tt.want {
+			t.Errorf("NMEAChecksum(%q) = %02X, want %02X", tt.sentence, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeNMEA(t *testing.T) {
+	var (
+		vancouver = NewObserver(49.274166666666666, -123.18533333333333, 70.3)
+		when      = time.Date(2002, time.July, 4, 22, 54, 44, 0, time.UTC)
+		eastern   = time.FixedZone("EST", -5*60*60)
+		india     = time.FixedZone("IST", 5*60*60+30*60)
+	)
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "GGA",
+			got:  EncodeGGA(vancouver, when),
+			want: "$GPGGA,225444.00,4916.4500,N,12311.1200,W,1,00,0.0,70.3,M,0.0,M,,*73",
+		},
+		{
+			name: "GGA with metadata",
+			got:  EncodeGGA(vancouver, when, NMEAFix{Talker: "GN", Quality: FixRTK, NumSatellites: 14, HDOP: 0.6, GeoidSeparation: -17.2}),
+			want: "$GNGGA,225444.00,4916.4500,N,12311.1200,W,4,14,0.6,70.3,M,-17.2,M,,*72",
+		},
+		{
+			name: "RMC",
+			got:  EncodeRMC(vancouver, when, NMEAFix{Speed: 22.4, Course: 84.4, MagneticVariation: -3.1}),
+			want: "$GPRMC,225444.00,A,4916.4500,N,12311.1200,W,22.4,84.4,040702,3.1,W*5E",
+		},
+		{
+			name: "GLL",
+			got:  EncodeGLL(vancouver, when),
+			want: "$GPGLL,4916.4500,N,12311.1200,W,225444.00,A,A*72",
+		},
+		{
+			name: "ZDA",
+			got:  EncodeZDA(time.Date(2002, time.July, 4, 15, 15, 30, 0, eastern)),
+			want: "$GPZDA,201530.00,04,07,2002,05,00*65",
+		},
+		{
+			name: "ZDA with zone",
+			got:  EncodeZDA(when, NMEAFix{Zone: india}),
+			want: "$GPZDA,225444.00,04,07,2002,-05,30*4F",
+		},
+		{
+			name: "ZDA in UTC",
+			got:  EncodeZDA(when),
+			want: "$GPZDA,225444.00,04,07,2002,00,00*64",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatNMEAAngle(t *testing.T) {
+	tests := []struct {
+		degrees float64
+		digits  int
+		want    string
+	}{
+		{0, 2, "0000.0000"},
+		{0, 3, "00000.0000"},
+		{43.6532, 2, "4339.1920"},
+		{-79.3832, 3, "07922.9920"},
+		{179.99999999, 3, "18000.0000"}, // 59.9999994' carries into the degrees
+		{10.5 + 0.00004/60, 2, "1030.0000"},
+		{90, 2, "9000.0000"},
+	}
+
+	for _, tt := range tests {
+		if got := formatNMEAAngle(tt.degrees, tt.digits); got != tt.want {
+			t.Errorf("formatNMEAAngle(%v, %d) = %s, want %s", tt.degrees, tt.digits, got, tt.want)
+		}
+	}
+}
+
+// TestEncodeNMEA_RoundTrip checks that random fixes parse back from every
+// encoded sentence type to the precision of the sentences
+func TestEncodeNMEA_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	start := time.Date(1950, time.January, 1, 0, 0, 0, 0, time.UTC)
+	span := time.Date(2049, time.December, 31, 0, 0, 0, 0, time.UTC).Sub(start)
+
+	const angleTolerance = 0.5e-4/60 + 1e-12 // half the last digit of the minutes
+	for i := range 1000 {
+		var (
+			loc  = NewObserver(rng.Float64()*180-90, rng.Float64()*360-180, rng.Float64()*2000-100)
+			when = start.Add(time.Duration(rng.Int64N(int64(span))))
+			meta = NMEAFix{
+				Quality:           FixQuality(rng.IntN(int(FixSimulated) + 1)),
+				NumSatellites:     rng.IntN(40),
+				HDOP:              rng.Float64() * 20,
+				GeoidSeparation:   rng.Float64()*200 - 100,
+				Speed:             rng.Float64() * 100,
+				Course:            rng.Float64() * 360,
+				MagneticVariation: rng.Float64()*60 - 30,
+				Zone:              time.FixedZone("", (rng.IntN(27*4)-13*4)*15*60),
+			}
+			utc = when.UTC().Round(10 * time.Millisecond)
+		)
+
+		for _, sentence := range []string{
+			EncodeGGA(loc, when, meta),
+			EncodeRMC(loc, when, meta),
+			EncodeGLL(loc, when, meta),
+			EncodeZDA(when, meta),
+		} {
+			fix, err := parseNMEA(sentence, utc.Year(), utc.Month(), utc.Day())
+			if err != nil {
+				t.Fatalf("%d: parseNMEA(%s) error = %v", i, sentence, err)
+			}
+			if !fix.Time.Equal(utc) {
+				t.Errorf("%d: %s time = %s, want %s", i, sentence, fix.Time, utc)
+			}
+			if fix.HasPosition && (!AlmostEqual(fix.Latitude, loc.Latitude(), angleTolerance) ||
+				!AlmostEqual(fix.Longitude, loc.Longitude(), angleTolerance)) {
+				t.Errorf("%d: %s position = %f, %f, want %f, %f", i, sentence, fix.Latitude, fix.Longitude, loc.Latitude(), loc.Longitude())
+			}
+
+			switch fix.Sentence {
+			case "GGA":
+				if fix.Quality != meta.Quality || fix.NumSatellites != meta.NumSatellites ||
+					!AlmostEqual(fix.HDOP, meta.HDOP, 0.05+1e-9) || !AlmostEqual(fix.Altitude, loc.Height(), 0.05+1e-9) ||
+					!AlmostEqual(fix.GeoidSeparation, meta.GeoidSeparation, 0.05+1e-9) {
+					t.Errorf("%d: %s = %+v, want %+v at %g m", i, sentence, fix, meta, loc.Height())
+				}
+			case "RMC":
+				if !AlmostEqual(fix.Speed, meta.Speed, 0.05+1e-9) || !AlmostEqual(fix.Course, meta.Course, 0.05+1e-9) ||
+					!AlmostEqual(fix.MagneticVariation, meta.MagneticVariation, 0.05+1e-9) {
+					t.Errorf("%d: %s = %+v, want %+v", i, sentence, fix, meta)
+				}
+			case "ZDA":
+				_, got := utc.In(fix.Zone).Zone()
+				_, want := utc.In(meta.Zone).Zone()
+				if got != want {
+					t.Errorf("%d: %s zone offset = %d, want %d", i, sentence, got, want)
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkEncodeGGA benchmarks formatting a GGA sentence
+func BenchmarkEncodeGGA(b *testing.B) {
+	obs := NewObserver(43.6532, -79.3832, 545.4)
+	when := time.Date(2024, time.June, 21, 12, 35, 19, 0, time.UTC)
+	meta := NMEAFix{Quality: FixGPS, NumSatellites: 8, HDOP: 0.9, GeoidSeparation: 46.9}
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = EncodeGGA(obs, when, meta)
+	}
+}
@@ -0,0 +1,289 @@
+package solar
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNMEALineTooLong is returned by NMEAReader for a line longer than
+	// MaxNMEALineLength, which is discarded.
+	ErrNMEALineTooLong = errors.New("NMEA line too long")
+
+	// ErrNMEAReaderClosed is returned by NMEAReader after Close.
+	ErrNMEAReaderClosed = errors.New("NMEA reader closed")
+)
+
+// MaxNMEALineLength is the longest line, in bytes, that NMEAReader accepts.
+// NMEA 0183 limits a sentence to 82 characters; the margin leaves room for
+// proprietary sentences and noise on the line.
+const MaxNMEALineLength = 256
+
+// NMEASentenceError is returned by NMEAReader for a sentence that cannot be
+// parsed. The reader skips the sentence and can go on reading.
+type NMEASentenceError struct {
+	// Sentence is the offending sentence, or the start of an oversized line.
+	Sentence string
+
+	// Err is the reason, such as ErrInvalidChecksum or ErrNMEALineTooLong.
+	Err error
+}
+
+// Error returns the reason and the offending sentence.
+func (e *NMEASentenceError) Error() string {
+	return fmt.Sprintf("%v: %q", e.Err, e.Sentence)
+}
+
+// Unwrap returns the reason, so errors.Is matches the package's sentinel errors.
+func (e *NMEASentenceError) Unwrap() error {
+	return e.Err
+}
+
+// NMEAReader reads position fixes from a stream of NMEA sentences, such as a
+// GPS receiver's serial port, a log file or a TCP socket.
+//
+// Lines may end with CR LF, LF or CR, and a line may arrive over several
+// reads. Anything before the "$" of a sentence is skipped, as is a line with
+// no sentence at all, so noise between sentences is ignored. A line longer
+// than MaxNMEALineLength is discarded and reported. Each sentence that cannot
+// be parsed is reported as an *NMEASentenceError without stopping the stream.
+//
+// The sentences are parsed by an NMEASession, so GGA, GLL and GNS sentences
+// take their date from the latest RMC or ZDA sentence or from SetDate, and
+// follow the day rollover at UTC midnight. They are reported with
+// ErrInvalidDate until a date is known. Sentences without a position, such as
+// ZDA, VTG, GSA and GSV, update the session but are not returned by Next.
+//
+// Next reads from the underlying reader itself when its context cannot be
+// cancelled, as with context.Background(). Otherwise it reads in a goroutine so
+// that it can return when the context is done; that goroutine reads a single
+// line and exits as soon as the read returns, keeping the line for the next
+// call to Next. No goroutine outlives the read in progress, so a reader that is
+// dropped without Close holds on to nothing once the underlying reader returns.
+//
+// An NMEAReader is not safe for concurrent use, except for Close.
+type NMEAReader struct {
+	src     *bufio.Reader
+	line    chan nmeaLine // the line of the read in progress in a goroutine
+	reading bool          // whether a goroutine is reading a line
+	done    chan struct{}
+	stop    sync.Once
+	session *NMEASession
+	pending []string // sentences of the last line not yet returned
+	err     error    // the error that ended the stream
+}
+
+// nmeaLine is a line read from the stream, or the error that ended it.
+type nmeaLine struct {
+	text    string
+	tooLong bool
+	err     error
+}
+
+// NewNMEAReader creates an NMEAReader that reads sentences from r.
+//
+// Parameters:
+//   - r: The stream of NMEA sentences
+//
+// Returns:
+//   - An NMEAReader
+//
+// Example:
+//
+//	reader := solar.NewNMEAReader(port)
+//	defer reader.Close()
+//	for {
+//	    fix, err := reader.Next(ctx)
+//	    if errors.Is(err, io.EOF) || ctx.Err() != nil {
+//	        break
+//	    }
+//	    if err != nil {
+//	        log.Print(err) // one bad sentence; keep reading
+//	        continue
+//	    }
+//	    fmt.Println(fix.Location().Latitude(), fix.Time)
+//	}
+func NewNMEAReader(r io.Reader) *NMEAReader {
+	return &NMEAReader{
+		src:     bufio.NewReader(r),
+		line:    make(chan nmeaLine, 1),
+		done:    make(chan struct{}),
+		session: NewNMEASession(),
+	}
+}
+
+// SetDate sets the UTC date given to the GGA, GLL and GNS sentences that
+// follow, which carry only the time of day, until an RMC or ZDA sentence or
+// the day rollover changes it.
+//
+// Parameters:
+//   - year, month, day: The UTC date of the fixes that follow
+func (r *NMEAReader) SetDate(year int, month time.Month, day int) {
+	r.session.SetDate(year, month, day)
+}
+
+// Session returns the session that parses the reader's sentences, which holds
+// the current date and the latest fix.
+func (r *NMEAReader) Session() *NMEASession {
+	return r.session
+}
+
+// Next returns the next position fix in the stream.
+//
+// A sentence that cannot be parsed is returned as an *NMEASentenceError, after
+// which Next can be called again for the sentences that follow. Next returns
+// io.EOF at the end of the stream, the error of the underlying reader if
+// reading fails, or the context's error if ctx is done first.
+//
+// A read from the underlying reader cannot be interrupted: when ctx is done,
+// Next returns at once but the read in progress continues in the background
+// until the underlying reader returns, and its line is returned by the next
+// call to Next. With a context that cannot be cancelled, Next waits for the
+// read itself.
+//
+// Parameters:
+//   - ctx: Context to cancel waiting for the next sentence
+//
+// Returns:
+//   - The fix, or an error
+func (r *NMEAReader) Next(ctx context.Context) (NMEAFix, error) {
+	for {
+		if len(r.pending) > 0 {
+			sentence := r.pending[0]
+			r.pending = r.pending[1:]
+			fix, err := r.parse(sentence)
+			if err == nil && !fix.HasPosition {
+				continue
+			}
+			return fix, err
+		}
+		if r.err != nil {
+			return NMEAFix{}, r.err
+		}
+		if err := ctx.Err(); err != nil {
+			return NMEAFix{}, err
+		}
+
+		line, err := r.nextLine(ctx)
+		if errors.Is(err, ErrNMEAReaderClosed) {
+			r.err = err
+			continue
+		}
+		if err != nil {
+			return NMEAFix{}, err
+		}
+
+		// Keep the error that ends the stream for the calls that follow
+		if line.err != nil {
+			r.err = line.err
+		}
+		if line.tooLong {
+			return NMEAFix{}, &NMEASentenceError{Sentence: line.text, Err: ErrNMEALineTooLong}
+		}
+		r.pending = splitNMEASentences(line.text)
+	}
+}
+
+// nextLine returns the next line of the stream, or the context's error if ctx
+// is done first, or ErrNMEAReaderClosed after Close.
+func (r *NMEAReader) nextLine(ctx context.Context) (nmeaLine, error) {
+	select {
+	case <-r.done:
+		return nmeaLine{}, ErrNMEAReaderClosed
+	default:
+	}
+
+	// Without a context to wait on, read here
+	if !r.reading && ctx.Done() == nil {
+		return r.readLine(), nil
+	}
+
+	// Otherwise read in a goroutine that ends with the read; the buffered
+	// channel keeps its line if Next has returned in the meantime
+	if !r.reading {
+		r.reading = true
+		go func() { r.line <- r.readLine() }()
+	}
+	select {
+	case <-ctx.Done():
+		return nmeaLine{}, ctx.Err()
+	case <-r.done:
+		return nmeaLine{}, ErrNMEAReaderClosed
+	case line := <-r.line:
+		r.reading = false
+		return line, nil
+	}
+}
+
+// Close makes Next return ErrNMEAReaderClosed, at once if it is waiting on a
+// context. It does not close the underlying reader, and a read already in
+// progress continues until it returns. Calling Close is not needed to release
+// the reader's resources.
+func (r *NMEAReader) Close() error {
+	r.stop.Do(func() { close(r.done) })
+	return nil
+}
+
+// parse parses one sentence into a fix.
+func (r *NMEAReader) parse(sentence string) (NMEAFix, error) {
+	fix, err := r.session.Parse(sentence)
+	if err != nil {
+		return NMEAFix{}, &NMEASentenceError{Sentence: sentence, Err: err}
+	}
+	return fix, nil
+}
+
+// readLine reads the next line, ended by CR, LF or the end of the stream. A
+// line longer than MaxNMEALineLength is read to its end and returned with
+// only its start.
+func (r *NMEAReader) readLine() nmeaLine {
+	var (
+		buf  = make([]byte, 0, 128)
+		line nmeaLine
+	)
+	for {
+		c, err := r.src.ReadByte()
+		if err != nil {
+			line.err = err
+			break
+		}
+		if c == '\r' || c == '\n' {
+			break
+		}
+		if len(buf) < MaxNMEALineLength {
+			buf = append(buf, c)
+		} else {
+			line.tooLong = true
+		}
+	}
+	line.text = string(buf)
+	return line
+}
+
+// splitNMEASentences returns the sentences of a line, each from a "$" up to the
+// next one or the end of the line, skipping whatever precedes the first.
+func splitNMEASentences(line string) []string {
+	var sentences []string
+	for {
+		start := strings.IndexByte(line, '$')
+		if start < 0 {
+			return sentences
+		}
+		line = line[start:]
+
+		end := strings.IndexByte(line[1:], '$') + 1
+		if end == 0 {
+			end = len(line)
+		}
+		if sentence := strings.TrimSpace(line[:end]); len(sentence) > 1 {
+			sentences = append(sentences, sentence)
+		}
+		line = line[end:]
+	}
+}
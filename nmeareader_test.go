@@ -0,0 +1,260 @@
+package solar
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+const validGSV = "$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,00*74"
+
+// readAllFixes reads the stream to its end, collecting the fixes and the
+// per-sentence errors
+func readAllFixes(t *testing.T, reader *NMEAReader) ([]NMEAFix, []error) {
+	t.Helper()
+	var (
+		fixes []NMEAFix
+		errs  []error
+	)
+	for range 100 {
+		fix, err := reader.Next(context.Background())
+		if errors.Is(err, io.EOF) {
+			return fixes, errs
+		}
+		if err != nil {
+			var sentenceErr *NMEASentenceError
+			if !errors.As(err, &sentenceErr) {
+				t.Fatalf("Next() error = %v, want an *NMEASentenceError", err)
+			}
+			errs = append(errs, err)
+			continue
+		}
+		fixes = append(fixes, fix)
+	}
+	t.Fatal("Next() did not reach the end of the stream")
+	return nil, nil
+}
+
+func TestNMEAReader(t *testing.T) {
+	stream := strings.Join([]string{
+		"garbage before the first sentence",
+		validRMC + "\r\n",
+		"\x00\x17noise" + validRMCSouth + "\n",
+		"$GPRMC,123519,A,4339.192,N,07922.992,W,022.4,084.4,230394,003.1,W*00\r\n",
+		validGSV + "\r$GPHDT,274.07,T*03\r",
+		"$" + strings.Repeat("X", 2*MaxNMEALineLength) + "\r\n",
+		validGGA + "\r\n",
+		"\r\n\r\n",
+		validRMC + validRMC,
+	}, "")
+
+	reader := NewNMEAReader(strings.NewReader(stream))
+	defer reader.Close()
+	fixes, errs := readAllFixes(t, reader)
+
+	// The GGA sentence takes the date of the RMC sentence before it
+	want := []string{validRMC, validRMCSouth, validGGA, validRMC, validRMC}
+	if len(fixes) != len(want) {
+		t.Fatalf("got %d fixes, want %d: %v", len(fixes), len(want), fixes)
+	}
+	for i, sentence := range want {
+		pos, err := parseNMEA(sentence, 2021, time.June, 21)
+		if err != nil {
+			t.Fatalf("parseNMEA() error = %v", err)
+		}
+		fix := fixes[i]
+		if fix.Latitude != pos.Latitude || fix.Longitude != pos.Longitude || !fix.Time.Equal(pos.Time) {
+			t.Errorf("fix %d = %+v, want %+v", i, fix, pos)
+		}
+		if fix.Talker+fix.Sentence != sentence[1:6] {
+			t.Errorf("fix %d ID = %s%s, want %s", i, fix.Talker, fix.Sentence, sentence[1:6])
+		}
+	}
+
+	wantErrs := []error{ErrInvalidChecksum, ErrUnsupportedSentence, ErrNMEALineTooLong}
+	if len(errs) != len(wantErrs) {
+		t.Fatalf("got errors %v, want %v", errs, wantErrs)
+	}
+	for i, want := range wantErrs {
+		if !errors.Is(errs[i], want) {
+			t.Errorf("error %d = %v, want %v", i, errs[i], want)
+		}
+	}
+
+	var tooLong *NMEASentenceError
+	if errors.As(errs[2], &tooLong) && len(tooLong.Sentence) != MaxNMEALineLength {
+		t.Errorf("oversized line reported with %d bytes, want %d", len(tooLong.Sentence), MaxNMEALineLength)
+	}
+}
+
+// TestNMEAReader_PartialLines checks that sentences arriving a byte at a time
+// are put back together
+func TestNMEAReader_PartialLines(t *testing.T) {
+	stream := validGGA + "\r\n" + validRMC + "\r\n" + validGGA + "\r\n"
+	reader := NewNMEAReader(iotest.OneByteReader(strings.NewReader(stream)))
+	defer reader.Close()
+
+	// Without a date, the first GGA sentence is rejected
+	if _, err := reader.Next(context.Background()); !errors.Is(err, ErrInvalidDate) {
+		t.Fatalf("Next() error = %v, want %v", err, ErrInvalidDate)
+	}
+
+	fixes, errs := readAllFixes(t, reader)
+	if len(errs) != 0 {
+		t.Fatalf("errors = %v", errs)
+	}
+	if len(fixes) != 2 || fixes[1].Sentence != "GGA" {
+		t.Fatalf("fixes = %v, want RMC and GGA", fixes)
+	}
+	if !fixes[0].Time.Equal(fixes[1].Time) || fixes[0].Location() != fixes[1].Location() {
+		t.Errorf("GGA fix %+v, want the same as RMC %+v", fixes[1], fixes[0])
+	}
+}
+
+func TestNMEAReader_Context(t *testing.T) {
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	reader := NewNMEAReader(pr)
+	defer reader.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := reader.Next(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Next() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The line that arrives later is not lost
+	go func() { _, _ = io.WriteString(pw, validRMC+"\r\n") }()
+	fix, err := reader.Next(context.Background())
+	if err != nil || fix.Sentence != "RMC" {
+		t.Errorf("Next() = %+v, %v, want the RMC fix", fix, err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err := reader.Next(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Next() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+// TestNMEAReader_Goroutines checks that a reader left without Close leaves no
+// goroutine behind once the read in progress returns
+func TestNMEAReader_Goroutines(t *testing.T) {
+	before := runtime.NumGoroutine()
+	waitGoroutines := func(what string) {
+		t.Helper()
+		for range 100 {
+			if runtime.NumGoroutine() <= before {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Errorf("%s: %d goroutines, want %d", what, runtime.NumGoroutine(), before)
+	}
+
+	// Without a context to wait on, Next reads without a goroutine
+	reader := NewNMEAReader(strings.NewReader(validRMC + "\r\n" + validRMC + "\r\n"))
+	if _, err := reader.Next(context.Background()); err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		t.Errorf("Next() started %d goroutines", n-before)
+	}
+
+	// The goroutine that reads for a cancellable context ends with its read
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	reader = NewNMEAReader(pr)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := reader.Next(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Next() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if _, err := io.WriteString(pw, validRMC+"\r\n"); err != nil {
+		t.Fatalf("WriteString() error = %v", err)
+	}
+	waitGoroutines("after the read")
+
+	// Its line is kept for the next call
+	if fix, err := reader.Next(context.Background()); err != nil || fix.Sentence != "RMC" {
+		t.Errorf("Next() = %+v, %v, want the RMC fix", fix, err)
+	}
+}
+
+func TestNMEAReader_Errors(t *testing.T) {
+	failure := errors.New("connection reset")
+	reader := NewNMEAReader(io.MultiReader(strings.NewReader(validRMC+"\n"+validRMC), iotest.ErrReader(failure)))
+	defer reader.Close()
+
+	for i := range 2 {
+		if _, err := reader.Next(context.Background()); err != nil {
+			t.Fatalf("Next() %d error = %v", i, err)
+		}
+	}
+	for range 2 {
+		if _, err := reader.Next(context.Background()); !errors.Is(err, failure) {
+			t.Errorf("Next() error = %v, want %v", err, failure)
+		}
+	}
+
+	// An oversized line at the end of the stream is reported before io.EOF
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	oversized := NewNMEAReader(strings.NewReader(validRMC + "\n$" + strings.Repeat("X", 400)))
+	defer oversized.Close()
+	for _, want := range []error{nil, ErrNMEALineTooLong, io.EOF, io.EOF} {
+		if _, err := oversized.Next(ctx); !errors.Is(err, want) {
+			t.Errorf("Next() with an oversized final line error = %v, want %v", err, want)
+		}
+	}
+
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	closed := NewNMEAReader(pr)
+	closed.Close()
+	if _, err := closed.Next(context.Background()); !errors.Is(err, ErrNMEAReaderClosed) {
+		t.Errorf("Next() after Close error = %v, want %v", err, ErrNMEAReaderClosed)
+	}
+}
+
+func TestSplitNMEASentences(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"", nil},
+		{"no sentence here", nil},
+		{"$GPRMC,1*00", []string{"$GPRMC,1*00"}},
+		{"xx$GPRMC,1*00  ", []string{"$GPRMC,1*00"}},
+		{"$GPRMC,1*00$GPGGA,2*00", []string{"$GPRMC,1*00", "$GPGGA,2*00"}},
+		{"$$GPRMC,1*00", []string{"$GPRMC,1*00"}},
+	}
+
+	for _, tt := range tests {
+		got := splitNMEASentences(tt.line)
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("splitNMEASentences(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+// BenchmarkNMEAReader benchmarks reading a stream of RMC sentences
+func BenchmarkNMEAReader(b *testing.B) {
+	stream := strings.Repeat(validRMC+"\r\n", 100)
+
+	b.ResetTimer()
+	for b.Loop() {
+		reader := NewNMEAReader(strings.NewReader(stream))
+		for {
+			if _, err := reader.Next(context.Background()); err != nil {
+				break
+			}
+		}
+		reader.Close()
+	}
+}
@@ -0,0 +1,148 @@
+package solar
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoNMEAFix is returned by NMEASession.Current before any fix.
+var ErrNoNMEAFix = errors.New("no NMEA fix yet")
+
+// nmeaRolloverThreshold is how far the time of a GGA fix must fall behind the
+// previous fix to be taken as the time of day wrapping past midnight, rather
+// than a sentence arriving out of order.
+const nmeaRolloverThreshold = 12 * time.Hour
+
+// NMEASession parses the sentences of one receiver in order, carrying the date
+// between them.
+//
+// RMC and ZDA sentences carry the date and set the session's date. GGA, GLL
+// and GNS sentences carry only the time of day and take the session's date;
+// when their time wraps from 23:59:59 to 00:00:00, the session moves on to the
+// next day, so a stream keeps the right date across UTC midnight without a new
+// RMC or ZDA. A sentence from before midnight that arrives after it keeps the
+// earlier date. Sentences with only the time of day that arrive before any
+// date is known are rejected with ErrInvalidDate, unless a date is given with
+// SetDate.
+//
+// An NMEASession is not safe for concurrent use.
+type NMEASession struct {
+	year  int
+	month time.Month
+	day   int
+
+	previous time.Time // time of the previous fix, for the day rollover
+	latest   NMEAFix
+	hasFix   bool
+}
+
+// NewNMEASession creates an NMEASession with no date.
+//
+// Example:
+//
+//	session := solar.NewNMEASession()
+//	for _, sentence := range sentences {
+//	    if _, err := session.Parse(sentence); err != nil {
+//	        continue
+//	    }
+//	}
+//	loc, t, err := session.Current()
+//	sunset, err := solar.Sunset(loc, t)
+func NewNMEASession() *NMEASession {
+	return &NMEASession{}
+}
+
+// SetDate sets the UTC date given to the GGA, GLL and GNS sentences that
+// follow, until an RMC or ZDA sentence or the day rollover changes it.
+//
+// Parameters:
+//   - year, month, day: The UTC date of the fixes that follow
+func (s *NMEASession) SetDate(year int, month time.Month, day int) {
+	s.year, s.month, s.day = year, month, day
+	s.previous = time.Time{}
+}
+
+// Date returns the session's current UTC date, and whether it is known.
+func (s *NMEASession) Date() (year int, month time.Month, day int, ok bool) {
+	return s.year, s.month, s.day, s.year != 0
+}
+
+// Parse parses the next sentence of the stream, updating the session's date.
+//
+// Parameters:
+//   - sentence: NMEA sentence string
+//
+// Returns:
+//   - The data of the sentence, or an error from parsing it, which leaves the session unchanged
+//
+// Example:
+//
+//	session := solar.NewNMEASession()
+//	session.Parse("$GPRMC,235959,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*66")
+//	fix, err := session.Parse("$GPGGA,000001,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*4B")
+//	// fix.Time is 1994-03-24 00:00:01 UTC
+func (s *NMEASession) Parse(sentence string) (NMEAFix, error) {
+	fix, err := parseNMEA(sentence, s.year, s.month, s.day)
+	if err != nil {
+		return NMEAFix{}, err
+	}
+
+	if nmeaTimeOfDayOnly(fix.Sentence) && !s.previous.IsZero() {
+		switch elapsed := fix.Time.Sub(s.previous); {
+		case elapsed < -nmeaRolloverThreshold:
+			// The time of day wrapped past midnight
+			fix.Time = fix.Time.AddDate(0, 0, 1)
+		case elapsed > nmeaRolloverThreshold:
+			// A late sentence from before midnight
+			fix.Time = fix.Time.AddDate(0, 0, -1)
+		}
+	}
+
+	if !fix.Time.IsZero() {
+		s.year, s.month, s.day = fix.Time.Date()
+		s.previous = fix.Time
+	}
+	if fix.HasPosition {
+		s.latest, s.hasFix = fix, true
+	}
+	return fix, nil
+}
+
+// Current returns the location and time of the latest position fix, ready for
+// Sunrise, Elevation and the other calculations. The location is at sea level;
+// Latest returns the fix, whose Observer accounts for its altitude.
+//
+// Returns:
+//   - The location and time of the latest fix, or ErrNoNMEAFix if there is none
+//
+// Example:
+//
+//	loc, t, err := session.Current()
+//	if err == nil {
+//	    elevation := solar.Elevation(loc, t.DateTime())
+//	}
+func (s *NMEASession) Current() (Location, Time, error) {
+	if !s.hasFix {
+		return Location{}, Time{}, ErrNoNMEAFix
+	}
+	return s.latest.Location(), s.latest.Date(), nil
+}
+
+// Latest returns the latest position fix with all its metadata, such as the
+// altitude, fix quality and HDOP.
+//
+// Returns:
+//   - The latest position fix, or ErrNoNMEAFix if there is none
+//
+// Example:
+//
+//	fix, err := session.Latest()
+//	if err == nil && fix.Quality != solar.FixInvalid && fix.HDOP < 5 {
+//	    sunrise, err := solar.Sunrise(fix.Observer(), fix.Date())
+//	}
+func (s *NMEASession) Latest() (NMEAFix, error) {
+	if !s.hasFix {
+		return NMEAFix{}, ErrNoNMEAFix
+	}
+	return s.latest, nil
+}
@@ -0,0 +1,160 @@
+package solar
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// GGA sentences around midnight in Toronto
+const (
+	ggaBeforeMidnight = "$GPGGA,235959,4339.192,N,07922.992,W,1,08,0.9,545.4,M,46.9,M,,*50"
+	ggaMidnight       = "$GPGGA,000000,4339.192,N,07922.992,W,1,08,0.9,545.4,M,46.9,M,,*51"
+	ggaAfterMidnight  = "$GPGGA,000001,4339.192,N,07922.992,W,1,08,0.9,545.4,M,46.9,M,,*50"
+	rmcEndOfMonth     = "$GPRMC,235958,A,4339.192,N,07922.992,W,022.4,084.4,310394,003.1,W*7F"
+)
+
+// TestNMEASession_Rollover checks that GGA fixes take the RMC date and follow
+// it across midnight, into the next month
+func TestNMEASession_Rollover(t *testing.T) {
+	session := NewNMEASession()
+	if _, err := session.Parse(ggaBeforeMidnight); !errors.Is(err, ErrInvalidDate) {
+		t.Fatalf("Parse(GGA) without a date error = %v, want %v", err, ErrInvalidDate)
+	}
+	if _, _, _, ok := session.Date(); ok {
+		t.Error("Date() is known after a rejected sentence")
+	}
+
+	tests := []struct {
+		sentence string
+		want     time.Time
+	}{
+		{rmcEndOfMonth, time.Date(1994, time.March, 31, 23, 59, 58, 0, time.UTC)},
+		{ggaBeforeMidnight, time.Date(1994, time.March, 31, 23, 59, 59, 0, time.UTC)},
+		{ggaMidnight, time.Date(1994, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{ggaBeforeMidnight, time.Date(1994, time.March, 31, 23, 59, 59, 0, time.UTC)}, // late
+		{ggaAfterMidnight, time.Date(1994, time.April, 1, 0, 0, 1, 0, time.UTC)},
+		{ggaAfterMidnight, time.Date(1994, time.April, 1, 0, 0, 1, 0, time.UTC)}, // repeated
+		{validRMC, time.Date(1994, time.March, 23, 12, 35, 19, 0, time.UTC)},
+		{validGGA, time.Date(1994, time.March, 23, 12, 35, 19, 0, time.UTC)},
+	}
+
+	for i, tt := range tests {
+		fix, err := session.Parse(tt.sentence)
+		if err != nil {
+			t.Fatalf("Parse() %d error = %v", i, err)
+		}
+		if !fix.Time.Equal(tt.want) {
+			t.Errorf("Parse() %d time = %s, want %s", i, fix.Time, tt.want)
+		}
+		if y, m, d, ok := session.Date(); !ok || y != tt.want.Year() || m != tt.want.Month() || d != tt.want.Day() {
+			t.Errorf("Date() %d = %d-%s-%d, want %s", i, y, m, d, tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+// TestNMEASession_ZDA checks that a ZDA sentence dates the GLL and GNS fixes
+// that follow, and that sentences without a position leave the latest fix
+func TestNMEASession_ZDA(t *testing.T) {
+	session := NewNMEASession()
+	for _, sentence := range []string{
+		"$GPZDA,201530.00,04,07,2002,00,00*60",
+		"$GPGLL,4916.45,N,12311.12,W,225444,A,A*5C",
+		"$GPVTG,054.7,T,034.4,M,005.5,N,010.2,K,A*25",
+		"$GPGSA,A,3,04,05,,09,12,,,24,,,,,2.5,1.3,2.1*39",
+	} {
+		if _, err := session.Parse(sentence); err != nil {
+			t.Fatalf("Parse(%s) error = %v", sentence, err)
+		}
+	}
+
+	fix, err := session.Parse("$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70")
+	if err != nil {
+		t.Fatalf("Parse(GNS) error = %v", err)
+	}
+	// The GNS time wraps past midnight after the GLL fix
+	if want := time.Date(2002, time.July, 5, 1, 40, 35, 0, time.UTC); !fix.Time.Equal(want) {
+		t.Errorf("GNS time = %s, want %s", fix.Time, want)
+	}
+
+	if _, err := session.Parse("$GPVTG,054.7,T,034.4,M,005.5,N,010.2,K,A*25"); err != nil {
+		t.Fatalf("Parse(VTG) error = %v", err)
+	}
+	loc, tm, err := session.Current()
+	if err != nil || loc != fix.Location() || tm != fix.Date() {
+		t.Errorf("Current() = %v, %v, %v, want the GNS fix", loc, tm.DateTime(), err)
+	}
+}
+
+func TestNMEASession_SetDate(t *testing.T) {
+	session := NewNMEASession()
+	session.SetDate(2024, time.June, 21)
+
+	fix, err := session.Parse(ggaMidnight)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC); !fix.Time.Equal(want) {
+		t.Errorf("Parse() time = %s, want %s", fix.Time, want)
+	}
+
+	// A new date is not taken for a rollover
+	session.SetDate(2024, time.June, 20)
+	fix, err = session.Parse(ggaBeforeMidnight)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := time.Date(2024, time.June, 20, 23, 59, 59, 0, time.UTC); !fix.Time.Equal(want) {
+		t.Errorf("Parse() after SetDate time = %s, want %s", fix.Time, want)
+	}
+}
+
+func TestNMEASession_Current(t *testing.T) {
+	session := NewNMEASession()
+	if _, _, err := session.Current(); !errors.Is(err, ErrNoNMEAFix) {
+		t.Fatalf("Current() error = %v, want %v", err, ErrNoNMEAFix)
+	}
+	if _, err := session.Latest(); !errors.Is(err, ErrNoNMEAFix) {
+		t.Fatalf("Latest() error = %v, want %v", err, ErrNoNMEAFix)
+	}
+
+	if _, err := session.Parse(validRMC); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	// A bad sentence leaves the latest fix
+	if _, err := session.Parse(validRMCSouth[:len(validRMCSouth)-2] + "00"); !errors.Is(err, ErrInvalidChecksum) {
+		t.Fatalf("Parse() error = %v, want %v", err, ErrInvalidChecksum)
+	}
+
+	latest, err := session.Latest()
+	if err != nil || latest.Sentence != "RMC" || latest.Speed != 22.4 {
+		t.Errorf("Latest() = %+v, %v, want the RMC fix", latest, err)
+	}
+	loc, tm, err := session.Current()
+	if err != nil {
+		t.Fatalf("Current() error = %v", err)
+	}
+	wantLoc, _ := NewLocationFromNMEA(validRMC, 0, 0, 0)
+	wantTime, _ := NewTimeFromNMEA(validRMC, 0, 0, 0)
+	if loc != wantLoc || tm != wantTime {
+		t.Errorf("Current() = %v, %v, want %v, %v", loc, tm.DateTime(), wantLoc, wantTime.DateTime())
+	}
+
+	sunrise, err := Sunrise(loc, tm)
+	if err != nil || sunrise.Day() != 23 {
+		t.Errorf("Sunrise() = %s, %v", sunrise, err)
+	}
+}
+
+// BenchmarkNMEASession benchmarks parsing a GGA sentence with the session's date
+func BenchmarkNMEASession(b *testing.B) {
+	session := NewNMEASession()
+	if _, err := session.Parse(validRMC); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for b.Loop() {
+		_, _ = session.Parse(validGGA)
+	}
+}
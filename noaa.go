@@ -0,0 +1,125 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// This file implements the algorithm behind the NOAA Solar Calculator and its
+// companion spreadsheet (https://gml.noaa.gov/grad/solcalc/), which is based on
+// Jean Meeus, "Astronomical Algorithms". Every quantity is a polynomial in the
+// Julian century, so the model stays accurate for several centuries.
+
+// noaaResult holds the quantities computed by the NOAA algorithm for a single
+// Julian day. All angles are in degrees.
+type noaaResult struct {
+	julianCentury     float64
+	geomMeanLongitude float64
+	geomMeanAnomaly   float64
+	eccentricity      float64
+	equationOfCenter  float64
+	trueLongitude     float64
+	trueAnomaly       float64
+	radiusVector      float64 // AU
+	apparentLongitude float64
+	meanObliquity     float64
+	obliquity         float64
+	rightAscension    float64
+	declination       float64
+	equationOfTime    float64 // minutes
+}
+
+// noaaPosition evaluates the NOAA solar ephemeris for the Julian day jd.
+func noaaPosition(jd float64) noaaResult {
+	var r noaaResult
+
+	t := (jd - J2000) / JulianCenturyDays
+	r.julianCentury = t
+
+	r.geomMeanLongitude = limitDegrees(280.46646 + t*(36000.76983+t*0.0003032))
+	r.geomMeanAnomaly = 357.52911 + t*(35999.05029-0.0001537*t)
+	r.eccentricity = 0.016708634 - t*(0.000042037+0.0000001267*t)
+
+	anomalyRad := r.geomMeanAnomaly * Degree
+	r.equationOfCenter = math.Sin(anomalyRad)*(1.914602-t*(0.004817+0.000014*t)) +
+		math.Sin(2*anomalyRad)*(0.019993-0.000101*t) +
+		math.Sin(3*anomalyRad)*0.000289
+
+	r.trueLongitude = r.geomMeanLongitude + r.equationOfCenter
+	r.trueAnomaly = r.geomMeanAnomaly + r.equationOfCenter
+	r.radiusVector = (1.000001018 * (1 - r.eccentricity*r.eccentricity)) /
+		(1 + r.eccentricity*math.Cos(r.trueAnomaly*Degree))
+
+	omega := (125.04 - 1934.136*t) * Degree
+	r.apparentLongitude = r.trueLongitude - 0.00569 - 0.00478*math.Sin(omega)
+
+	r.meanObliquity = 23 + (26+(21.448-t*(46.815+t*(0.00059-t*0.001813)))/60)/60
+	r.obliquity = r.meanObliquity + 0.00256*math.Cos(omega)
+
+	var (
+		lambdaRad  = r.apparentLongitude * Degree
+		epsilonRad = r.obliquity * Degree
+	)
+	r.rightAscension = math.Atan2(math.Cos(epsilonRad)*math.Sin(lambdaRad), math.Cos(lambdaRad)) / Degree
+	r.declination = math.Asin(math.Sin(epsilonRad)*math.Sin(lambdaRad)) / Degree
+
+	var (
+		y         = math.Pow(math.Tan(epsilonRad/2), 2)
+		l0Rad     = r.geomMeanLongitude * Degree
+		e         = r.eccentricity
+		sinM      = math.Sin(anomalyRad)
+		eqTimeRad = y*math.Sin(2*l0Rad) - 2*e*sinM + 4*e*y*sinM*math.Cos(2*l0Rad) -
+			0.5*y*y*math.Sin(4*l0Rad) - 1.25*e*e*math.Sin(2*anomalyRad)
+	)
+	r.equationOfTime = 4 * eqTimeRad / Degree
+
+	return r
+}
+
+// noaaSunPosition computes the sun's position at the given instant following
+// the NOAA spreadsheet.
+func noaaSunPosition(latitude, longitude float64, when time.Time) SunPosition {
+	var (
+		jd = TimeToJulianDay(when)
+		r  = noaaPosition(jd)
+
+		// True solar time in minutes, from the fraction of the UTC day
+		dayFraction   = jd + 0.5 - math.Floor(jd+0.5)
+		trueSolarTime = math.Mod(dayFraction*1440+r.equationOfTime+4*longitude, 1440)
+	)
+	if trueSolarTime < 0 {
+		trueSolarTime += 1440
+	}
+
+	hourAngle := trueSolarTime/4 - HalfCircleDegrees
+	elevation, azimuth := horizontalCoordinates(latitude, r.declination, hourAngle)
+
+	return SunPosition{
+		Elevation:         elevation,
+		Azimuth:           azimuth,
+		Zenith:            90 - elevation,
+		HourAngle:         hourAngle,
+		Declination:       r.declination,
+		RightAscension:    limitDegrees(r.rightAscension),
+		EquationOfTime:    r.equationOfTime,
+		EclipticLongitude: limitDegrees(r.apparentLongitude),
+		Distance:          r.radiusVector,
+	}
+}
+
+// horizontalCoordinates converts a declination and local hour angle (degrees,
+// positive west of the meridian) into elevation and azimuth (clockwise from
+// north) for an observer at the given latitude.
+func horizontalCoordinates(latitude, declination, hourAngle float64) (elevation, azimuth float64) {
+	var (
+		latRad  = latitude * Degree
+		declRad = declination * Degree
+		haRad   = hourAngle * Degree
+	)
+	elevation = math.Asin(math.Sin(latRad)*math.Sin(declRad)+
+		math.Cos(latRad)*math.Cos(declRad)*math.Cos(haRad)) / Degree
+	azimuth = limitDegrees(math.Atan2(math.Sin(haRad),
+		math.Cos(haRad)*math.Sin(latRad)-math.Tan(declRad)*math.Cos(latRad))/Degree +
+		HalfCircleDegrees)
+	return elevation, azimuth
+}
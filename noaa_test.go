@@ -0,0 +1,200 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestNOAAPosition_Spreadsheet checks the ephemeris against the default row of
+// the NOAA Solar Calculations spreadsheet: Boulder, Colorado (40° N, 105° W,
+// UTC-6) on 2010-06-21 at 00:06 local time.
+func TestNOAAPosition_Spreadsheet(t *testing.T) {
+	jd := TimeToJulianDay(time.Date(2010, time.June, 21, 6, 6, 0, 0, time.UTC))
+	r := noaaPosition(jd)
+
+	tests := []struct {
+		name      string
+		got       float64
+		want      float64
+		tolerance float64
+	}{
+		{"JulianCentury", r.julianCentury, 0.104688684, 1e-9},
+		{"GeomMeanLongitude", r.geomMeanLongitude, 89.33966, 1e-5},
+		{"GeomMeanAnomaly", r.geomMeanAnomaly, 4126.22229, 1e-5},
+		{"Eccentricity", r.eccentricity, 0.016704232, 1e-9},
+		{"EquationOfCenter", r.equationOfCenter, 0.446800, 1e-6},
+		{"TrueLongitude", r.trueLongitude, 89.78646, 1e-5},
+		{"TrueAnomaly", r.trueAnomaly, 4126.66909, 1e-5},
+		{"RadiusVector", r.radiusVector, 1.016240, 1e-6},
+		{"ApparentLongitude", r.apparentLongitude, 89.78544, 1e-5},
+		{"MeanObliquity", r.meanObliquity, 23.43793, 1e-5},
+		{"Obliquity", r.obliquity, 23.43849, 1e-5},
+		{"RightAscension", r.rightAscension, 89.76614, 1e-5},
+		{"Declination", r.declination, 23.43831, 1e-5},
+		{"EquationOfTime", r.equationOfTime, -1.70631, 1e-5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > tt.tolerance {
+				t.Errorf("%s = %.9f, want %.9f (±%g)", tt.name, tt.got, tt.want, tt.tolerance)
+			}
+		})
+	}
+}
+
+// dataNOAASunriseSunset holds sunrise and sunset times as shown by the NOAA
+// Solar Calculator, which rounds to the minute.
+var dataNOAASunriseSunset = []struct {
+	name      string
+	latitude  float64
+	longitude float64
+	date      Time
+	sunrise   time.Time
+	sunset    time.Time
+}{
+	{
+		name:      "Boulder 2010-06-21",
+		latitude:  40,
+		longitude: -105,
+		date:      NewTime(2010, time.June, 21),
+		sunrise:   time.Date(2010, time.June, 21, 11, 31, 0, 0, time.UTC),
+		sunset:    time.Date(2010, time.June, 22, 2, 32, 0, 0, time.UTC),
+	},
+	{
+		name:      "Toronto 2000-01-01",
+		latitude:  43.65,
+		longitude: -79.38,
+		date:      NewTime(2000, time.January, 1),
+		sunrise:   time.Date(2000, time.January, 1, 12, 51, 0, 0, time.UTC),
+		sunset:    time.Date(2000, time.January, 1, 21, 50, 0, 0, time.UTC),
+	},
+	{
+		name:      "London 2022-06-21",
+		latitude:  51.5072,
+		longitude: -0.1276,
+		date:      NewTime(2022, time.June, 21),
+		sunrise:   time.Date(2022, time.June, 21, 3, 43, 0, 0, time.UTC),
+		sunset:    time.Date(2022, time.June, 21, 20, 21, 0, 0, time.UTC),
+	},
+}
+
+func TestSunriseSunset_NOAA(t *testing.T) {
+	for _, tt := range dataNOAASunriseSunset {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := NewLocation(tt.latitude, tt.longitude)
+			sunrise, sunset, err := SunriseSunset(loc, tt.date, NOAA)
+			if err != nil {
+				t.Fatalf("SunriseSunset(NOAA) error = %v", err)
+			}
+			if d := sunrise.Sub(tt.sunrise); d < -time.Minute || d > time.Minute {
+				t.Errorf("sunrise = %s, want %s", sunrise, tt.sunrise)
+			}
+			if d := sunset.Sub(tt.sunset); d < -time.Minute || d > time.Minute {
+				t.Errorf("sunset = %s, want %s", sunset, tt.sunset)
+			}
+
+			// The per-observer setting gives the same result
+			rise, err := Sunrise(loc.WithAlgorithm(NOAA), tt.date)
+			if err != nil {
+				t.Fatalf("Sunrise(WithAlgorithm(NOAA)) error = %v", err)
+			}
+			if !rise.Equal(sunrise) {
+				t.Errorf("Sunrise(WithAlgorithm(NOAA)) = %s, want %s", rise, sunrise)
+			}
+			set, err := Sunset(loc, tt.date, NOAA)
+			if err != nil {
+				t.Fatalf("Sunset(NOAA) error = %v", err)
+			}
+			if !set.Equal(sunset) {
+				t.Errorf("Sunset(NOAA) = %s, want %s", set, sunset)
+			}
+		})
+	}
+}
+
+func TestSunriseSunset_NOAAPolar(t *testing.T) {
+	loc := NewLocation(69.3321443, -81.6781126)
+
+	_, _, err := SunriseSunset(loc, NewTime(2020, time.June, 25), NOAA)
+	if err != ErrSunNeverSets {
+		t.Errorf("midnight sun error = %v, want %v", err, ErrSunNeverSets)
+	}
+
+	_, _, err = SunriseSunset(loc, NewTime(2020, time.December, 21), NOAA)
+	if err != ErrSunNeverRises {
+		t.Errorf("polar night error = %v, want %v", err, ErrSunNeverRises)
+	}
+}
+
+// TestElevationAzimuth_NOAA checks NOAA positions against the SPA, which the
+// NOAA algorithm matches to within a few hundredths of a degree.
+func TestElevationAzimuth_NOAA(t *testing.T) {
+	for _, tt := range dataAzimuth {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := NewLocation(tt.latitude, tt.longitude)
+
+			elevation := Elevation(loc, tt.when, NOAA)
+			elevationSPA := Elevation(loc, tt.when, SPA)
+			if math.Abs(elevation-elevationSPA) > 0.02 {
+				t.Errorf("Elevation(NOAA) = %.4f, SPA = %.4f", elevation, elevationSPA)
+			}
+
+			// Azimuth is ill-conditioned with the sun near the zenith
+			if elevationSPA > 85 {
+				return
+			}
+
+			azimuth := Azimuth(loc.WithAlgorithm(NOAA), tt.when)
+			azimuthSPA := Azimuth(loc, tt.when, SPA)
+			if math.Abs(azimuth-azimuthSPA) > 0.05 {
+				t.Errorf("Azimuth(NOAA) = %.4f, SPA = %.4f", azimuth, azimuthSPA)
+			}
+		})
+	}
+}
+
+func TestDawnDusk_NOAA(t *testing.T) {
+	loc := NewLocation(43.65, -79.38).WithAlgorithm(NOAA)
+	tm := NewTime(2024, time.June, 21)
+
+	dawn, dusk := DawnDusk(loc, tm, Nautical)
+	if dawn.IsZero() || dusk.IsZero() {
+		t.Fatal("DawnDusk(NOAA) returned zero times")
+	}
+
+	// The NOAA and sunrise-equation results should agree to within a minute
+	refDawn, refDusk := DawnDusk(NewLocation(43.65, -79.38), tm, Nautical)
+	if d := dawn.Sub(refDawn); d < -time.Minute || d > time.Minute {
+		t.Errorf("dawn = %s, sunrise equation = %s", dawn, refDawn)
+	}
+	if d := dusk.Sub(refDusk); d < -time.Minute || d > time.Minute {
+		t.Errorf("dusk = %s, sunrise equation = %s", dusk, refDusk)
+	}
+
+	if d := Dawn(loc, tm, Nautical); !d.Equal(dawn) {
+		t.Errorf("Dawn(NOAA) = %s, want %s", d, dawn)
+	}
+	if d := Dusk(loc, tm, Nautical); !d.Equal(dusk) {
+		t.Errorf("Dusk(NOAA) = %s, want %s", d, dusk)
+	}
+
+	// Never reached: the sun does not get 18° below the horizon in a
+	// London midsummer night
+	dawn, dusk = DawnDusk(NewLocation(51.5072, -0.1276).WithAlgorithm(NOAA), NewTime(2022, time.June, 21), Astronomical)
+	if !dawn.IsZero() || !dusk.IsZero() {
+		t.Errorf("DawnDusk(NOAA, Astronomical) = %s, %s, want zero times", dawn, dusk)
+	}
+}
+
+// BenchmarkSunriseSunset_NOAA benchmarks the NOAA sunrise/sunset calculation
+func BenchmarkSunriseSunset_NOAA(b *testing.B) {
+	loc := NewLocation(40.7128, -74.0060)
+	tm := NewTime(2024, time.June, 21)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_, _, _ = SunriseSunset(loc, tm, NOAA)
+	}
+}
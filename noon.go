@@ -14,15 +14,16 @@ func meanSolarNoonInternal(longitude float64, year int, month time.Month, day in
 // MeanSolarNoon calculates the time at which the sun is at its highest altitude
 // (solar noon) for the given location and date.
 //
-// All times are calculated and returned in UTC. To convert to local time, use
-// time.In() with the appropriate timezone.
+// The time is returned in UTC. For a Time created with NewTimeIn or
+// NewTimeFromLocalDateTime, it falls within that local civil day and is
+// returned in its time zone.
 //
 // Parameters:
 //   - loc: Location created via NewLocation() or NewLocationFromNMEA()
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
 //
 // Returns:
-//   - Solar noon time in UTC
+//   - Solar noon time in UTC, or in the time zone of t
 //
 // Example:
 //
@@ -31,6 +32,122 @@ func meanSolarNoonInternal(longitude float64, year int, month time.Month, day in
 //	noon := solar.MeanSolarNoon(loc, t)
 //	// noon is in UTC - convert to local time if needed
 func MeanSolarNoon(loc Location, t Time) time.Time {
-	jd := meanSolarNoonInternal(loc.Longitude(), t.Year(), t.Month(), t.Day())
-	return JulianDayToTime(jd)
+	noon, _ := onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		return JulianDayToTime(meanSolarNoonInternal(loc.Longitude(), year, month, day)), nil
+	})
+	return noon
+}
+
+// Culmination describes the sun when it crosses the local meridian: at its
+// highest at solar noon (upper culmination) or at its lowest at solar midnight
+// (lower culmination).
+type Culmination struct {
+	// Time is the instant of the meridian crossing.
+	Time time.Time
+
+	// Elevation is the true elevation of the sun's center at that instant,
+	// in degrees: the maximum elevation of the day at solar noon, and the
+	// minimum at solar midnight.
+	Elevation float64
+
+	// Azimuth is the compass direction of the sun at that instant, in
+	// degrees: due south (180°) or due north (0°).
+	Azimuth float64
+}
+
+// culminationTime returns the instant of the given local apparent solar time
+// (hour 12 or 0) on the given date, in UTC.
+func culminationTime(loc Location, hour, year int, month time.Month, day int) time.Time {
+	return LocalApparentSolarTimeToUTC(loc, time.Date(year, month, day, hour, 0, 0, 0, time.UTC))
+}
+
+// culminationAt returns the meridian crossing at the given local apparent
+// solar time (hour 12 or 0) on the given date, in UTC.
+func culminationAt(loc Location, hour, year int, month time.Month, day int) Culmination {
+	var (
+		when = culminationTime(loc, hour, year, month, day)
+		pos  = Position(loc.WithTimeScale(UTC), when)
+	)
+	return Culmination{Time: when, Elevation: pos.Elevation, Azimuth: pos.Azimuth}
+}
+
+// culminationOnDay returns the meridian crossing at the given local apparent
+// solar time on the day t, within the local civil day of a zoned t.
+func culminationOnDay(loc Location, t Time, hour int) Culmination {
+	var computed []Culmination
+	when, _ := onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		c := culminationAt(loc, hour, year, month, day)
+		computed = append(computed, c)
+		return c.Time, nil
+	})
+
+	// Return the crossing that onDay chose, in the zone of t
+	for _, c := range computed {
+		if c.Time.Equal(when) {
+			c.Time = when
+			return c
+		}
+	}
+	return Culmination{}
+}
+
+// SolarNoon calculates true solar noon, the instant at which the sun crosses
+// the local meridian at its highest, together with the sun's elevation and
+// azimuth at that moment.
+//
+// Unlike MeanSolarNoon, which only accounts for the longitude, true solar noon
+// includes the equation of time and moves by up to a quarter of an hour over
+// the year. It is 12:00 local apparent solar time, computed with the
+// location's algorithm. The elevation is the maximum of the day: negative
+// values mean polar night, and an elevation below -6° means that the day
+// never even reaches civil twilight.
+//
+// The time is returned in UTC. For a Time created with NewTimeIn or
+// NewTimeFromLocalDateTime, it falls within that local civil day and is
+// returned in its time zone.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//
+// Returns:
+//   - The instant of solar noon with the sun's maximum elevation and its azimuth
+//
+// Example:
+//
+//	loc := solar.NewLocation(43.65, -79.38)
+//	noon := solar.SolarNoon(loc, solar.NewTime(2024, time.June, 21))
+//	fmt.Printf("%s: %.2f° high\n", noon.Time.Format("15:04:05"), noon.Elevation)
+func SolarNoon(loc Location, t Time) Culmination {
+	return culminationOnDay(loc, t, 12)
+}
+
+// SolarMidnight calculates true solar midnight, the instant at which the sun
+// crosses the local meridian at its lowest, together with the sun's elevation
+// and azimuth at that moment. It is the lower culmination that starts the
+// solar day, 00:00 local apparent solar time, about twelve hours before
+// SolarNoon.
+//
+// The elevation is the minimum of the day: positive values mean midnight sun,
+// and values between 0° and -6° mean that the night never gets darker than
+// civil twilight.
+//
+// The time is returned in UTC. For a Time created with NewTimeIn or
+// NewTimeFromLocalDateTime, it falls within that local civil day and is
+// returned in its time zone.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//
+// Returns:
+//   - The instant of solar midnight with the sun's minimum elevation and its azimuth
+//
+// Example:
+//
+//	loc := solar.NewLocation(69.65, 18.96) // Tromsø
+//	midnight := solar.SolarMidnight(loc, solar.NewTime(2024, time.June, 21))
+//	// midnight.Elevation is about +3°: the midnight sun
+func SolarMidnight(loc Location, t Time) Culmination {
+	return culminationOnDay(loc, t, 0)
 }
@@ -1,6 +1,7 @@
 package solar
 
 import (
+	"math"
 	"testing"
 	"time"
 )
@@ -191,3 +192,114 @@ func BenchmarkMeanSolarNoonFromNMEA_GGA(b *testing.B) {
 		_ = MeanSolarNoon(loc, tm)
 	}
 }
+
+// TestSolarNoon checks that the sun is on the meridian at solar noon and
+// midnight, at the elevations given by the latitude and declination.
+func TestSolarNoon(t *testing.T) {
+	tests := []struct {
+		name     string
+		location Location
+		date     Time
+		noonAz   float64
+	}{
+		{"Toronto solstice", NewLocation(43.65, -79.38), NewTime(2024, time.June, 21), 180},
+		{"Toronto winter", NewLocation(43.65, -79.38), NewTime(2024, time.December, 21), 180},
+		{"Sydney", NewLocation(-33.87, 151.21), NewTime(2024, time.March, 1), 0},
+		{"Tromsø", NewLocation(69.65, 18.96), NewTime(2024, time.June, 21), 180},
+		{"Honolulu", NewLocation(21.31, -157.86), NewTime(2024, time.November, 3), 180},
+	}
+
+	for _, tt := range tests {
+		for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+			t.Run(tt.name+"/"+algorithm.String(), func(t *testing.T) {
+				loc := tt.location.WithAlgorithm(algorithm)
+				noon := SolarNoon(loc, tt.date)
+				midnight := SolarMidnight(loc, tt.date)
+
+				pos := Position(loc, noon.Time)
+				if algorithm != SunriseEquation && math.Abs(pos.HourAngle) > 0.005 {
+					t.Errorf("hour angle at noon = %f, want 0", pos.HourAngle)
+				}
+				upper := 90 - math.Abs(loc.Latitude()-pos.Declination)
+				if !AlmostEqual(noon.Elevation, upper, 0.02) {
+					t.Errorf("noon elevation = %f, want %f", noon.Elevation, upper)
+				}
+				if d := math.Abs(signedDegrees(noon.Azimuth - tt.noonAz)); d > 0.5 {
+					t.Errorf("noon azimuth = %f, want %.0f", noon.Azimuth, tt.noonAz)
+				}
+
+				lower := math.Abs(loc.Latitude()+Position(loc, midnight.Time).Declination) - 90
+				if !AlmostEqual(midnight.Elevation, lower, 0.02) {
+					t.Errorf("midnight elevation = %f, want %f", midnight.Elevation, lower)
+				}
+				if d := noon.Time.Sub(midnight.Time); d < 11*time.Hour+50*time.Minute || d > 12*time.Hour+10*time.Minute {
+					t.Errorf("midnight is %s before noon, want about 12h", d)
+				}
+
+				// Solar noon is the highest point of the day
+				for _, offset := range []time.Duration{-10 * time.Minute, 10 * time.Minute} {
+					if e := Position(loc, noon.Time.Add(offset)).Elevation; e >= noon.Elevation {
+						t.Errorf("elevation %s from noon = %f, not below %f", offset, e, noon.Elevation)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestSolarNoon_EquationOfTime checks that true noon differs from mean noon by
+// the equation of time.
+func TestSolarNoon_EquationOfTime(t *testing.T) {
+	loc := NewLocation(51.48, -2.59).WithAlgorithm(NOAA)
+	tm := NewTime(2024, time.November, 3)
+
+	noon := SolarNoon(loc, tm).Time
+	mean := MeanSolarNoon(loc, tm)
+	if d := mean.Sub(noon).Minutes(); !AlmostEqual(d, EquationOfTime(noon, NOAA), 0.01) {
+		t.Errorf("mean - true noon = %f min, want the equation of time %f", d, EquationOfTime(noon, NOAA))
+	}
+}
+
+// TestSolarNoon_PolarNight tells a civil-dark polar night from a fully dark one
+// by the maximum elevation of the day.
+func TestSolarNoon_PolarNight(t *testing.T) {
+	tm := NewTime(2024, time.December, 21)
+
+	// Tromsø: the sun stays below the horizon but civil twilight remains
+	if e := SolarNoon(NewLocation(69.65, 18.96), tm).Elevation; e >= 0 || e < CivilTwilightAngle {
+		t.Errorf("Tromsø noon elevation = %f, want between -6 and 0", e)
+	}
+	// Longyearbyen: not even civil twilight at noon
+	if e := SolarNoon(NewLocation(78.22, 15.65), tm).Elevation; e >= CivilTwilightAngle {
+		t.Errorf("Longyearbyen noon elevation = %f, want below -6", e)
+	}
+}
+
+// TestSolarNoon_Zone checks that solar noon and midnight fall within a local
+// civil day and are returned in its time zone.
+func TestSolarNoon_Zone(t *testing.T) {
+	zone := time.FixedZone("LINT", 14*60*60)
+	loc := NewLocation(1.87, -157.4) // Kiritimati
+	tm := NewTimeIn(2024, time.January, 15, zone)
+	start := tm.DateTime()
+
+	for name, c := range map[string]Culmination{"noon": SolarNoon(loc, tm), "midnight": SolarMidnight(loc, tm)} {
+		if c.Time.Before(start) || !c.Time.Before(start.AddDate(0, 0, 1)) {
+			t.Errorf("%s = %s, not on %s", name, c.Time, start.Format("2006-01-02"))
+		}
+		if c.Time.Location() != zone {
+			t.Errorf("%s location = %v, want %v", name, c.Time.Location(), zone)
+		}
+	}
+}
+
+// BenchmarkSolarNoon benchmarks true solar noon
+func BenchmarkSolarNoon(b *testing.B) {
+	loc := NewLocation(43.65, -79.38)
+	tm := NewTime(2024, time.June, 21)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = SolarNoon(loc, tm)
+	}
+}
@@ -0,0 +1,150 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// Observer is a Location that also describes the observer's height above sea
+// level and the state of the atmosphere. It is the same type as Location, so an
+// Observer can be passed to every function that takes a Location.
+//
+// The height lowers the visible horizon (horizon dip), so the sun rises
+// earlier and sets later for an observer on a mountain or in an aircraft. The
+// pressure and temperature scale the atmospheric refraction used for sunrise,
+// sunset and the apparent elevation.
+type Observer = Location
+
+// NewObserver creates an Observer at the given coordinates and height, in the
+// standard atmosphere (StandardPressure and StandardTemperature).
+//
+// Parameters:
+//   - latitude: Decimal degrees, positive north, negative south (-90 to +90)
+//   - longitude: Decimal degrees, positive east, negative west (-180 to +180)
+//   - height: Meters above sea level
+//
+// Example:
+//
+//	// Summit of Whistler Mountain on a cold day
+//	obs := solar.NewObserver(50.0593, -122.9493, 2182).
+//	    WithPressure(780).
+//	    WithTemperature(-8)
+//	sunrise, err := solar.Sunrise(obs, solar.NewTime(2024, time.January, 15))
+func NewObserver(latitude, longitude, height float64) Observer {
+	return NewLocation(latitude, longitude).WithHeight(height)
+}
+
+// Height returns the observer's height above sea level in meters.
+func (l Location) Height() float64 {
+	return l.height
+}
+
+// Pressure returns the atmospheric pressure at the observer in millibars (hPa).
+func (l Location) Pressure() float64 {
+	return StandardPressure + l.pressureOffset
+}
+
+// Temperature returns the air temperature at the observer in degrees Celsius.
+func (l Location) Temperature() float64 {
+	return StandardTemperature + l.temperatureOffset
+}
+
+// WithHeight returns a copy of the Location at the given height above sea
+// level, in meters. Negative heights are treated as sea level for the horizon
+// dip.
+func (l Location) WithHeight(height float64) Location {
+	l.height = height
+	return l
+}
+
+// WithPressure returns a copy of the Location with the given atmospheric
+// pressure, in millibars (hPa). A pressure of zero disables refraction.
+func (l Location) WithPressure(pressure float64) Location {
+	l.pressureOffset = pressure - StandardPressure
+	return l
+}
+
+// WithTemperature returns a copy of the Location with the given air
+// temperature, in degrees Celsius.
+func (l Location) WithTemperature(temperature float64) Location {
+	l.temperatureOffset = temperature - StandardTemperature
+	return l
+}
+
+// Refraction returns the refraction model used at this location.
+func (l Location) Refraction() RefractionModel {
+	return l.refraction
+}
+
+// WithRefraction returns a copy of the Location that uses the given
+// atmospheric refraction model for the apparent elevation and for sunrise and
+// sunset.
+//
+// Example:
+//
+//	// Geometric sunrise: the sun's upper limb on the true horizon
+//	loc := solar.NewLocation(40.7128, -74.0060).WithRefraction(solar.RefractionNone)
+//	sunrise, err := solar.Sunrise(loc, t)
+func (l Location) WithRefraction(model RefractionModel) Location {
+	l.refraction = model
+	return l
+}
+
+// VaryingSemidiameter reports whether sunrise and sunset use the sun's
+// apparent radius on the date instead of the mean SolarSemidiameter.
+func (l Location) VaryingSemidiameter() bool {
+	return l.varyingSemidiameter
+}
+
+// WithVaryingSemidiameter returns a copy of the Location whose sunrise and
+// sunset use the apparent radius of the sun on the date, from the Earth–sun
+// distance, instead of the mean 16 arc minutes of SolarSemidiameter. The radius
+// is about 16.3′ in January and 15.7′ in July, which moves the times by a few
+// seconds at mid-latitudes.
+//
+// Example:
+//
+//	loc := solar.NewLocation(43.65, -79.38).WithVaryingSemidiameter(true)
+//	sunrise, err := solar.Sunrise(loc, solar.NewTime(2025, time.January, 4))
+func (l Location) WithVaryingSemidiameter(varying bool) Location {
+	l.varyingSemidiameter = varying
+	return l
+}
+
+// horizon returns the geometric elevation of the sun's center, in radians, at
+// sunrise and sunset for this observer. A sea-level observer in the standard
+// atmosphere, including the zero Location, gets exactly SunriseCorrectionAngle.
+func (l Location) horizon() float64 {
+	if l.height == 0 && l.refraction == RefractionSPA &&
+		l.pressureOffset == 0 && l.temperatureOffset == 0 {
+		return SunriseCorrectionAngle
+	}
+
+	refraction := l.refraction.horizonRefraction(l.Pressure(), l.Temperature())
+	return -(refraction + SolarSemidiameter + horizonDip(l.height)) * Degree
+}
+
+// horizonOn returns the horizon of the observer, in radians, for sunrise and
+// sunset on the given UTC date. With a varying semidiameter, the mean
+// SolarSemidiameter is replaced by the sun's apparent radius at noon on that
+// date, computed with the given algorithm.
+func (l Location) horizonOn(algorithm Algorithm, year int, month time.Month, day int) float64 {
+	if !l.varyingSemidiameter {
+		return l.horizon()
+	}
+
+	jd := TimeToJulianDay(time.Date(year, month, day, 12, 0, 0, 0, time.UTC))
+	radius := angularRadius(earthSunDistance(algorithm, jd))
+	return l.horizon() - (radius-SolarSemidiameter)*Degree
+}
+
+// horizonDip returns how far, in degrees, the visible horizon lies below the
+// astronomical horizon for an observer at the given height in meters. It uses
+// the navigator's approximation of 1.76 arc minutes per square root of the
+// height, which includes terrestrial refraction.
+func horizonDip(height float64) float64 {
+	if height <= 0 {
+		return 0
+	}
+	return 1.76 / 60 * math.Sqrt(height)
+}
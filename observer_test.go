@@ -0,0 +1,189 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewObserver(t *testing.T) {
+	obs := NewObserver(50.0593, -122.9493, 2182)
+
+	if obs.Latitude() != 50.0593 || obs.Longitude() != -122.9493 {
+		t.Errorf("coordinates = %v, %v, want 50.0593, -122.9493", obs.Latitude(), obs.Longitude())
+	}
+	if obs.Height() != 2182 {
+		t.Errorf("Height() = %v, want 2182", obs.Height())
+	}
+	if obs.Pressure() != StandardPressure {
+		t.Errorf("Pressure() = %v, want %v", obs.Pressure(), StandardPressure)
+	}
+	if obs.Temperature() != StandardTemperature {
+		t.Errorf("Temperature() = %v, want %v", obs.Temperature(), StandardTemperature)
+	}
+
+	obs = obs.WithPressure(780).WithTemperature(-8).WithHeight(2000)
+	if obs.Pressure() != 780 || obs.Temperature() != -8 || obs.Height() != 2000 {
+		t.Errorf("With methods = %v mbar, %v °C, %v m, want 780, -8, 2000",
+			obs.Pressure(), obs.Temperature(), obs.Height())
+	}
+
+	// The copy keeps the algorithm
+	obs = NewLocation(0, 0).WithAlgorithm(SPA).WithHeight(100)
+	if obs.Algorithm() != SPA {
+		t.Errorf("Algorithm() = %v, want SPA", obs.Algorithm())
+	}
+}
+
+func TestLocationHorizon(t *testing.T) {
+	tests := []struct {
+		name string
+		loc  Location
+		want float64 // degrees
+	}{
+		{"Sea level standard atmosphere", NewLocation(45, 0), SunriseCorrectionAngle / Degree},
+		{"Explicit standard atmosphere", NewObserver(45, 0, 0).WithPressure(StandardPressure), SunriseCorrectionAngle / Degree},
+		{"No atmosphere", NewLocation(45, 0).WithPressure(0), -SolarSemidiameter},
+		{"No refraction", NewLocation(45, 0).WithRefraction(RefractionNone), -SolarSemidiameter},
+		{"Bennett ignores pressure", NewLocation(45, 0).WithRefraction(RefractionBennett).WithPressure(500), -(HorizonRefraction + SolarSemidiameter)},
+		{"Height 100 m", NewObserver(45, 0, 100), -(HorizonRefraction + SolarSemidiameter + 1.76/6)},
+		{"Below sea level", NewObserver(31.5, 35.5, -430), -(HorizonRefraction + SolarSemidiameter)},
+		{"Cold dense air", NewLocation(45, 0).WithPressure(1040).WithTemperature(-30), -(HorizonRefraction*1040/1010*283/243 + SolarSemidiameter)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.loc.horizon() / Degree; !AlmostEqual(got, tt.want, 1e-9) {
+				t.Errorf("horizon() = %.6f°, want %.6f°", got, tt.want)
+			}
+		})
+	}
+
+	// The default path must reproduce the legacy constant exactly
+	if NewLocation(45, 0).horizon() != SunriseCorrectionAngle {
+		t.Error("horizon() of a default location differs from SunriseCorrectionAngle")
+	}
+}
+
+// TestSunriseSunset_Observer checks that a raised horizon dip brings sunrise
+// forward and sunset back by the time the sun takes to cover the extra angle.
+// TestLocationHorizon_ZeroValue checks that the zero Location is at sea level
+// in the standard atmosphere, like NewLocation
+func TestLocationHorizon_ZeroValue(t *testing.T) {
+	var zero Location
+	if zero.Pressure() != StandardPressure || zero.Temperature() != StandardTemperature {
+		t.Errorf("Location{} atmosphere = %g mbar, %g°C, want %g mbar, %g°C",
+			zero.Pressure(), zero.Temperature(), StandardPressure, StandardTemperature)
+	}
+	if got := zero.horizon(); got != SunriseCorrectionAngle {
+		t.Errorf("Location{}.horizon() = %g°, want %g°", got/Degree, SunriseCorrectionAngle/Degree)
+	}
+	if zero != NewLocation(0, 0) {
+		t.Errorf("Location{} = %+v, want NewLocation(0, 0)", zero)
+	}
+
+	tm := NewTime(2024, time.March, 1)
+	got, err := Sunrise(zero, tm)
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	if want, _ := Sunrise(NewLocation(0, 0), tm); !got.Equal(want) {
+		t.Errorf("Sunrise(Location{}) = %s, want %s", got, want)
+	}
+
+	// An explicit zero pressure still disables refraction
+	if NewLocation(0, 0).WithPressure(0).horizon() == SunriseCorrectionAngle {
+		t.Error("WithPressure(0).horizon() is the standard horizon")
+	}
+}
+
+func TestSunriseSunset_Observer(t *testing.T) {
+	tm := NewTime(2024, time.January, 15)
+
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			sea := NewLocation(50.0593, -122.9493)
+			mountain := NewObserver(50.0593, -122.9493, 2182)
+
+			seaRise, seaSet, err := SunriseSunset(sea, tm, algorithm)
+			if err != nil {
+				t.Fatalf("SunriseSunset(sea level) error = %v", err)
+			}
+			rise, set, err := SunriseSunset(mountain, tm, algorithm)
+			if err != nil {
+				t.Fatalf("SunriseSunset(mountain) error = %v", err)
+			}
+
+			// The dip at 2182 m is about 1.37°, which takes the sun
+			// roughly nine minutes in a Canadian January
+			for _, d := range []time.Duration{seaRise.Sub(rise), set.Sub(seaSet)} {
+				if d < 7*time.Minute || d > 12*time.Minute {
+					t.Errorf("mountain gains %s, want 7-12 minutes", d)
+				}
+			}
+
+			// Both ends agree with TimeOfElevation at the observer's horizon
+			morning, evening := TimeOfElevation(sea.WithAlgorithm(algorithm), mountain.horizon()/Degree, tm)
+			if d := rise.Sub(morning); d < -time.Second || d > time.Second {
+				t.Errorf("Sunrise = %s, TimeOfElevation = %s", rise, morning)
+			}
+			if d := set.Sub(evening); d < -time.Second || d > time.Second {
+				t.Errorf("Sunset = %s, TimeOfElevation = %s", set, evening)
+			}
+		})
+	}
+}
+
+// TestSunriseSunset_ObserverAtmosphere checks that refraction scales with the
+// density of the air.
+func TestSunriseSunset_ObserverAtmosphere(t *testing.T) {
+	tm := NewTime(2024, time.June, 21)
+	loc := NewLocation(43.65, -79.38)
+
+	standard, err := Sunrise(loc, tm)
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	dense, err := Sunrise(loc.WithPressure(1040).WithTemperature(-20), tm)
+	if err != nil {
+		t.Fatalf("Sunrise(dense) error = %v", err)
+	}
+	vacuum, err := Sunrise(loc.WithPressure(0), tm)
+	if err != nil {
+		t.Fatalf("Sunrise(vacuum) error = %v", err)
+	}
+
+	if !dense.Before(standard) {
+		t.Errorf("dense air sunrise %s should be before %s", dense, standard)
+	}
+	if !vacuum.After(standard) {
+		t.Errorf("airless sunrise %s should be after %s", vacuum, standard)
+	}
+}
+
+// TestPosition_ObserverHeight checks that the SPA applies parallax for the
+// observer's height, which shifts the elevation by less than a millidegree.
+func TestPosition_ObserverHeight(t *testing.T) {
+	ref := spaReference
+	obs := NewObserver(ref.latitude, ref.longitude, ref.height)
+
+	elevation := Elevation(obs, ref.when, SPA)
+	if math.Abs(elevation-39.872046) > 0.0003 {
+		t.Errorf("Elevation(SPA) = %.6f, want 39.872046", elevation)
+	}
+	if sea := Elevation(NewLocation(ref.latitude, ref.longitude), ref.when, SPA); elevation == sea {
+		t.Error("observer height has no effect on the SPA elevation")
+	}
+}
+
+// BenchmarkSunriseSunset_Observer benchmarks sunrise/sunset for an observer
+// with a custom height and atmosphere
+func BenchmarkSunriseSunset_Observer(b *testing.B) {
+	obs := NewObserver(40.7128, -74.0060, 300).WithPressure(980).WithTemperature(25)
+	tm := NewTime(2024, time.June, 21)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_, _, _ = SunriseSunset(obs, tm)
+	}
+}
@@ -1,7 +1,109 @@
 package solar
 
+import (
+	"math"
+	"time"
+)
+
 // argumentOfPerihelion calculates the argument of periapsis for the earth on
 // the given Julian day.
 func argumentOfPerihelion(d float64) float64 {
 	return PerihelionBase + PerihelionRate*(d-J2000)/JulianCenturyDays
 }
+
+// longitudeOfPerihelion calculates the longitude of the earth's perihelion,
+// in degrees, referred to the mean equinox of the date, for the given number
+// of Julian centuries since J2000 (Meeus, "Astronomical Algorithms", table
+// 31.A).
+func longitudeOfPerihelion(t float64) float64 {
+	return 102.937348 + t*(1.7195269+t*(0.00045962+t*0.000000499))
+}
+
+// apsisWindow is the half-width, in days, of the interval around the mean
+// instant of an apsis that is searched for the extreme distance.
+const apsisWindow = 3
+
+// apsisJDE returns the Julian Ephemeris Day (Terrestrial Time) of the Earth's
+// perihelion (aphelion false) or aphelion (true) in the given year.
+//
+// The Moon shifts the Earth's apsides by up to a couple of days from those of
+// the Earth-Moon barycenter, so the estimate of meanApsisJDE is refined by
+// searching for the extreme of the SPA (VSOP87) radius vector around it.
+func apsisJDE(year int, aphelion bool) float64 {
+	var (
+		jde      = meanApsisJDE(year, aphelion)
+		distance = func(jde float64) float64 {
+			return spaEarthValue(spaRTerms[:], (jde-J2000)/JulianCenturyDays/10)
+		}
+	)
+	return extremum(distance, jde-apsisWindow, jde+apsisWindow, aphelion)
+}
+
+// meanApsisJDE returns an estimate of the Julian Ephemeris Day of the Earth's
+// perihelion or aphelion in the given year, following Meeus, "Astronomical
+// Algorithms" (2nd ed.), chapter 38, with the corrections of table 38.B.
+func meanApsisJDE(year int, aphelion bool) float64 {
+	k := math.Round(0.99997 * (float64(year) - 2000.01))
+	if aphelion {
+		k += 0.5
+	}
+	jde := 2451547.507 + 365.2596358*k + 0.0000000156*k*k
+
+	var (
+		a1 = (328.41 + 132.788585*k) * Degree
+		a2 = (316.13 + 584.903153*k) * Degree
+		a3 = (346.20 + 450.380738*k) * Degree
+		a4 = (136.95 + 659.306737*k) * Degree
+		a5 = (249.52 + 329.653368*k) * Degree
+	)
+	if aphelion {
+		return jde - 1.352*math.Sin(a1) + 0.061*math.Sin(a2) + 0.062*math.Sin(a3) +
+			0.029*math.Sin(a4) + 0.031*math.Sin(a5)
+	}
+	return jde + 1.278*math.Sin(a1) - 0.055*math.Sin(a2) - 0.091*math.Sin(a3) -
+		0.056*math.Sin(a4) - 0.045*math.Sin(a5)
+}
+
+// Perihelion returns the instant in the given year at which the Earth is
+// closest to the sun, in early January.
+//
+// The instant is estimated with Meeus, "Astronomical Algorithms", chapter 38,
+// and then refined against the Earth-sun distance of the SPA algorithm. The
+// distance changes very slowly around an apsis, so the instant is accurate to
+// within an hour.
+//
+// Parameters:
+//   - year: Year (e.g., 2025)
+//
+// Returns:
+//   - The instant of perihelion in UTC
+//
+// Example:
+//
+//	perihelion := solar.Perihelion(2025)
+//	// 2025-01-04 around 13:28 UTC
+func Perihelion(year int) time.Time {
+	return JulianDayToTime(apsisJDE(year, false), TT)
+}
+
+// Aphelion returns the instant in the given year at which the Earth is
+// farthest from the sun, in early July.
+//
+// The instant is estimated with Meeus, "Astronomical Algorithms", chapter 38,
+// and then refined against the Earth-sun distance of the SPA algorithm. The
+// distance changes very slowly around an apsis, so the instant is accurate to
+// within an hour.
+//
+// Parameters:
+//   - year: Year (e.g., 2025)
+//
+// Returns:
+//   - The instant of aphelion in UTC
+//
+// Example:
+//
+//	aphelion := solar.Aphelion(2025)
+//	// 2025-07-03 around 19:55 UTC
+func Aphelion(year int) time.Time {
+	return JulianDayToTime(apsisJDE(year, true), TT)
+}
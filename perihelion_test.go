@@ -1,7 +1,9 @@
 package solar
 
 import (
+	"math"
 	"testing"
+	"time"
 )
 
 var dataArgumentOfPerihelion = []struct {
@@ -27,3 +29,65 @@ func TestArgumentOfPerihelion(t *testing.T) {
 		}
 	}
 }
+
+// dataApsides holds the perihelia and aphelia published by the U.S. Naval
+// Observatory, to the minute in UTC.
+var dataApsides = []struct {
+	year                 int
+	perihelion, aphelion string
+}{
+	{2020, "01-05 07:48", "07-04 11:35"},
+	{2021, "01-02 13:51", "07-05 22:27"},
+	{2022, "01-04 06:52", "07-04 07:11"},
+	{2023, "01-04 16:17", "07-06 20:07"},
+	{2024, "01-03 00:39", "07-05 05:06"},
+	{2025, "01-04 13:28", "07-03 19:55"},
+}
+
+func TestApsides(t *testing.T) {
+	for _, tt := range dataApsides {
+		for name, e := range map[string]struct {
+			got  time.Time
+			want string
+		}{
+			"Perihelion": {Perihelion(tt.year), tt.perihelion},
+			"Aphelion":   {Aphelion(tt.year), tt.aphelion},
+		} {
+			want := parseUTC(t, tt.year, e.want)
+			if d := e.got.Sub(want); d < -time.Hour || d > time.Hour {
+				t.Errorf("%s(%d) = %s, want %s", name, tt.year, e.got, want)
+			}
+		}
+	}
+}
+
+// TestMeanApsisJDE checks that the Meeus estimate, which the refinement starts
+// from, is within the searched window.
+func TestMeanApsisJDE(t *testing.T) {
+	for year := 1900; year <= 2100; year++ {
+		for _, aphelion := range []bool{false, true} {
+			mean, refined := meanApsisJDE(year, aphelion), apsisJDE(year, aphelion)
+			if d := math.Abs(refined - mean); d > apsisWindow/2 {
+				t.Fatalf("apsisJDE(%d, %t) = %f, %f days from the estimate", year, aphelion, refined, d)
+			}
+		}
+	}
+}
+
+// BenchmarkPerihelion benchmarks the perihelion calculation
+func BenchmarkPerihelion(b *testing.B) {
+	b.ResetTimer()
+	for b.Loop() {
+		_ = Perihelion(2025)
+	}
+}
+
+func TestLongitudeOfPerihelion(t *testing.T) {
+	if v := longitudeOfPerihelion(0); !AlmostEqual(v, 102.937348, 1e-9) {
+		t.Errorf("longitudeOfPerihelion(0) = %f, want 102.937348", v)
+	}
+	// The perihelion advances by about 1.7° per century against the equinox
+	if d := longitudeOfPerihelion(1) - longitudeOfPerihelion(0); !AlmostEqual(d, 1.72, 0.01) {
+		t.Errorf("advance per century = %f, want about 1.72", d)
+	}
+}
@@ -0,0 +1,260 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// DayPhase is the phase of the sky at an instant, from the sun's elevation and
+// whether it is morning or evening.
+type DayPhase int
+
+const (
+	// Night is when the sun is more than 18° below the horizon.
+	Night DayPhase = iota
+
+	// MorningAstronomicalTwilight is when the sun is between 18° and 12° below
+	// the horizon before solar noon.
+	MorningAstronomicalTwilight
+
+	// MorningNauticalTwilight is when the sun is between 12° and 6° below the
+	// horizon before solar noon.
+	MorningNauticalTwilight
+
+	// MorningCivilTwilight is when the sun is between 6° and 4° below the
+	// horizon before solar noon: the civil twilight before the golden hour,
+	// which is the blue hour.
+	MorningCivilTwilight
+
+	// MorningGoldenHour is when the sun is between 4° below and 6° above the
+	// horizon before solar noon, the elevations of the GoldenHour twilight
+	// type. Sunrise falls within it.
+	MorningGoldenHour
+
+	// Day is when the sun is more than 6° above the horizon.
+	Day
+
+	// EveningGoldenHour is when the sun is between 6° above and 4° below the
+	// horizon after solar noon, the elevations of the GoldenHour twilight type.
+	// Sunset falls within it.
+	EveningGoldenHour
+
+	// EveningCivilTwilight is when the sun is between 4° and 6° below the
+	// horizon after solar noon: the civil twilight after the golden hour, which
+	// is the blue hour.
+	EveningCivilTwilight
+
+	// EveningNauticalTwilight is when the sun is between 6° and 12° below the
+	// horizon after solar noon.
+	EveningNauticalTwilight
+
+	// EveningAstronomicalTwilight is when the sun is between 12° and 18° below
+	// the horizon after solar noon.
+	EveningAstronomicalTwilight
+)
+
+// String returns the name of the day phase.
+func (p DayPhase) String() string {
+	switch p {
+	case MorningAstronomicalTwilight:
+		return "MorningAstronomicalTwilight"
+	case MorningNauticalTwilight:
+		return "MorningNauticalTwilight"
+	case MorningCivilTwilight:
+		return "MorningCivilTwilight"
+	case MorningGoldenHour:
+		return "MorningGoldenHour"
+	case Day:
+		return "Day"
+	case EveningGoldenHour:
+		return "EveningGoldenHour"
+	case EveningCivilTwilight:
+		return "EveningCivilTwilight"
+	case EveningNauticalTwilight:
+		return "EveningNauticalTwilight"
+	case EveningAstronomicalTwilight:
+		return "EveningAstronomicalTwilight"
+	default:
+		return "Night"
+	}
+}
+
+// DayPhasePeriod is the phase of the sky at an instant and the period over
+// which it lasts.
+type DayPhasePeriod struct {
+	// Phase is the phase of the sky at the instant.
+	Phase DayPhase
+
+	// Start is the instant at which the phase began, or the zero time if it
+	// began more than a day before the instant.
+	Start time.Time
+
+	// End is the instant at which the phase ends, or the zero time if it ends
+	// more than a day after the instant.
+	End time.Time
+
+	// Elevation is the sun's true elevation at the instant, in degrees.
+	Elevation float64
+}
+
+// phaseSearchDays is how far, in days, the start and end of a phase are
+// searched for on either side of the instant.
+const phaseSearchDays = 1
+
+// DayPhaseAt classifies the sky at an instant at the specified location and
+// finds when that phase started and when it ends.
+//
+// The phases are bands of the sun's true elevation, using the existing twilight
+// angles: night below AstronomicalTwilightAngle (-18°), astronomical and
+// nautical twilight, civil twilight up to the golden hour, the golden hour
+// between the elevations of the GoldenHour twilight type (-4° to 6°), and day
+// above it. Civil twilight is thus the blue hour (-6° to -4°), and sunrise and
+// sunset fall within the golden hour rather than changing the phase. Twilight
+// and golden hour are morning phases before solar noon and evening phases
+// after it, so a sun that stays within one band around noon or midnight, near
+// the polar circles, changes phase there.
+//
+// The boundaries are computed from the same sun as Dawn, Dusk and
+// TimeOfElevation, so they are the times those give for the band elevations:
+// with the sunrise equation, the sun of each mean solar day, and when the
+// location asks for refinement, the sun's position at each instant. With NOAA
+// and SPA without refinement, whose event times are estimates, they are within
+// a few seconds of them.
+//
+// The start and end of the phase are found by sampling the phase every ten
+// minutes for up to a day on either side of the instant, and are zero times
+// beyond that, as during the midnight sun or the polar night. As with
+// ElevationCrossings, a dip into another band between two samples, such as a
+// sun that barely rises near the polar circles, is found from the extremum of
+// the elevation, so End does not skip a phase however brief.
+//
+// Parameters:
+//   - loc: Location created via NewLocation(), NewObserver() or NewLocationFromNMEA()
+//   - when: The moment to classify (in UTC)
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
+//
+// Returns:
+//   - The phase, its start and end in the time zone of when, and the sun's elevation
+//
+// Example:
+//
+//	loc := solar.NewLocation(43.65, -79.38)
+//	p := solar.DayPhaseAt(loc, time.Now())
+//	if p.Phase == solar.EveningCivilTwilight {
+//	    fmt.Println("lights on; dark at", p.End)
+//	}
+func DayPhaseAt(loc Location, when time.Time, algorithm ...Algorithm) DayPhasePeriod {
+	var (
+		alg  = selectAlgorithm(loc, algorithm)
+		jd   = TimeToJulianDay(when)
+		zone = when.Location()
+	)
+
+	// Follow the sun the event functions use, so that the boundaries are their
+	// times: the sunrise equation's own unless the location asks for refinement
+	sun := func(jd float64) (DayPhase, float64) {
+		var elevation, hourAngle float64
+		if alg == SunriseEquation && !loc.Refinement() {
+			elevation, hourAngle = sunriseEquationSun(loc, jd)
+		} else {
+			elevation, hourAngle = instantSun(alg, loc, jd)
+		}
+		return classifyDayPhase(elevation, hourAngle), elevation
+	}
+	current, elevation := sun(jd)
+
+	period := DayPhasePeriod{Phase: current, Elevation: elevation}
+	if start, ok := phaseBoundary(sun, current, jd, -crossingStep); ok {
+		period.Start = JulianDayToTime(start).In(zone)
+	}
+	if end, ok := phaseBoundary(sun, current, jd, crossingStep); ok {
+		period.End = JulianDayToTime(end).In(zone)
+	}
+	return period
+}
+
+// classifyDayPhase returns the day phase for the sun at the given elevation
+// and hour angle (degrees).
+func classifyDayPhase(elevation, hourAngle float64) DayPhase {
+	var (
+		phase                    DayPhase
+		goldenUpper, goldenLower = GoldenHour.Elevations()
+	)
+	switch {
+	case elevation >= goldenUpper:
+		return Day
+	case elevation < AstronomicalTwilightAngle:
+		return Night
+	case elevation >= goldenLower:
+		phase = MorningGoldenHour
+	case elevation >= CivilTwilightAngle:
+		phase = MorningCivilTwilight
+	case elevation >= NauticalTwilightAngle:
+		phase = MorningNauticalTwilight
+	default:
+		phase = MorningAstronomicalTwilight
+	}
+
+	// The evening phases mirror the morning ones around Day
+	if hourAngle >= 0 {
+		phase = 2*Day - phase
+	}
+	return phase
+}
+
+// phaseBoundary steps from the Julian day jd, where the phase is current, by
+// step days at a time until the phase changes, and locates the change to
+// within crossingTolerance. sun returns the phase and the elevation at a
+// Julian day. Like ElevationCrossings, it looks between samples for an
+// extremum of the elevation, so that a dip into another band that is shorter
+// than a step is not stepped over. It reports false if the phase does not
+// change within phaseSearchDays.
+func phaseBoundary(sun func(float64) (DayPhase, float64), current DayPhase, jd, step float64) (float64, bool) {
+	var (
+		steps  = int(phaseSearchDays/Abs(step) + 0.5)
+		x0, x1 = jd, jd + step
+		_, e0  = sun(x0)
+		p1, e1 = sun(x1)
+	)
+	if p1 != current {
+		return bisectPhase(sun, current, x0, x1), true
+	}
+
+	elevation := func(jd float64) float64 {
+		_, e := sun(jd)
+		return e
+	}
+	for i := 2; i <= steps; i++ {
+		x2 := jd + float64(i)*step
+		p2, e2 := sun(x2)
+
+		if (e1-e0)*(e2-e1) < 0 {
+			xe := extremum(elevation, math.Min(x0, x2), math.Max(x0, x2), e1 > e0)
+			if pe, _ := sun(xe); pe != current {
+				return bisectPhase(sun, current, x0, xe), true
+			}
+		}
+		if p2 != current {
+			return bisectPhase(sun, current, x1, x2), true
+		}
+
+		x0, e0 = x1, e1
+		x1, e1 = x2, e2
+	}
+	return 0, false
+}
+
+// bisectPhase locates the change of phase between the Julian day inside, where
+// the phase is current, and outside, where it is not, to within
+// crossingTolerance.
+func bisectPhase(sun func(float64) (DayPhase, float64), current DayPhase, inside, outside float64) float64 {
+	for Abs(outside-inside) > crossingTolerance {
+		m := (inside + outside) / 2
+		if p, _ := sun(m); p == current {
+			inside = m
+		} else {
+			outside = m
+		}
+	}
+	return (inside + outside) / 2
+}
@@ -0,0 +1,257 @@
+package solar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClassifyDayPhase(t *testing.T) {
+	tests := []struct {
+		elevation float64
+		hourAngle float64
+		want      DayPhase
+	}{
+		{30, -40, Day},
+		{30, 40, Day},
+		{6, 60, Day},
+		{3, -70, MorningGoldenHour},
+		{3, 70, EveningGoldenHour},
+		{-0.5, -80, MorningGoldenHour},
+		{-1, 80, EveningGoldenHour},
+		{-4, 85, EveningGoldenHour},
+		{-4.5, 90, EveningCivilTwilight},
+		{-6, -100, MorningCivilTwilight},
+		{-8, 100, EveningNauticalTwilight},
+		{-12, -110, MorningNauticalTwilight},
+		{-15, -120, MorningAstronomicalTwilight},
+		{-15, 120, EveningAstronomicalTwilight},
+		{-18, 130, EveningAstronomicalTwilight},
+		{-30, -170, Night},
+		{-30, 170, Night},
+		// Solar midnight starts the morning
+		{-10, -180, MorningNauticalTwilight},
+	}
+
+	for _, tt := range tests {
+		if got := classifyDayPhase(tt.elevation, tt.hourAngle); got != tt.want {
+			t.Errorf("classifyDayPhase(%g, %g) = %s, want %s", tt.elevation, tt.hourAngle, got, tt.want)
+		}
+	}
+}
+
+// TestDayPhaseAt checks each phase of an ordinary day in Toronto and that its
+// boundaries are the crossings of the twilight angles. Refined, every
+// algorithm follows the sun's position, whose crossings ElevationCrossings
+// finds
+func TestDayPhaseAt(t *testing.T) {
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			loc := NewLocation(43.65, -79.38).WithAlgorithm(algorithm).WithRefinement(true)
+			zone := time.FixedZone("EST", -5*60*60)
+			day := time.Date(2024, time.February, 1, 0, 0, 0, 0, zone)
+
+			tests := []struct {
+				hour         float64
+				want         DayPhase
+				lower, upper float64
+			}{
+				{3, Night, AstronomicalTwilightAngle, AstronomicalTwilightAngle},
+				{6, MorningAstronomicalTwilight, AstronomicalTwilightAngle, NauticalTwilightAngle},
+				{6.5, MorningNauticalTwilight, NauticalTwilightAngle, CivilTwilightAngle},
+				{7.15, MorningCivilTwilight, CivilTwilightAngle, BlueHourAngle},
+				{7.75, MorningGoldenHour, BlueHourAngle, GoldenHourAngle},
+				{12, Day, GoldenHourAngle, GoldenHourAngle},
+				{17.1, EveningGoldenHour, GoldenHourAngle, BlueHourAngle},
+				{17.85, EveningCivilTwilight, BlueHourAngle, CivilTwilightAngle},
+				{18, EveningNauticalTwilight, CivilTwilightAngle, NauticalTwilightAngle},
+				{18.6, EveningAstronomicalTwilight, NauticalTwilightAngle, AstronomicalTwilightAngle},
+				{22, Night, AstronomicalTwilightAngle, AstronomicalTwilightAngle},
+			}
+
+			for _, tt := range tests {
+				when := day.Add(time.Duration(tt.hour * float64(time.Hour)))
+				p := DayPhaseAt(loc, when)
+				if p.Phase != tt.want {
+					t.Errorf("DayPhaseAt(%s) = %s, want %s", when.Format("15:04"), p.Phase, tt.want)
+					continue
+				}
+				if p.Elevation != Elevation(loc, when) && algorithm != SunriseEquation {
+					t.Errorf("DayPhaseAt(%s) elevation = %f, want %f", when.Format("15:04"), p.Elevation, Elevation(loc, when))
+				}
+				if !p.Start.Before(when) || !p.End.After(when) {
+					t.Fatalf("DayPhaseAt(%s) = %s to %s, want a period around it", when.Format("15:04"), p.Start, p.End)
+				}
+				if p.Start.Location() != zone || p.End.Location() != zone {
+					t.Errorf("DayPhaseAt(%s) locations = %v, %v, want %v", when.Format("15:04"), p.Start.Location(), p.End.Location(), zone)
+				}
+
+				// The boundaries are the crossings of the band's elevations
+				for _, b := range []struct {
+					when      time.Time
+					elevation float64
+				}{{p.Start, tt.lower}, {p.End, tt.upper}} {
+					crossings := ElevationCrossings(loc, b.elevation, b.when.Add(-time.Minute), b.when.Add(time.Minute))
+					if len(crossings) != 1 {
+						t.Errorf("DayPhaseAt(%s) boundary %s is not a crossing of %g°", when.Format("15:04"), b.when, b.elevation)
+						continue
+					}
+					if d := crossings[0].Time.Sub(b.when); d < -time.Millisecond || d > time.Millisecond {
+						t.Errorf("DayPhaseAt(%s) boundary = %s, crossing at %s", when.Format("15:04"), b.when, crossings[0].Time)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestDayPhaseAt_GoldenHour checks that the evening golden hour spans the
+// elevations of the GoldenHour twilight type, sunset included
+func TestDayPhaseAt_GoldenHour(t *testing.T) {
+	loc := NewObserver(43.65, -79.38, 500).WithAlgorithm(SPA)
+	refined := loc.WithRefinement(true)
+	tm := NewTime(2024, time.June, 21)
+	start, end, err := EveningTwilight(refined, tm, GoldenHour)
+	if err != nil {
+		t.Fatalf("EveningTwilight() error = %v", err)
+	}
+	sunset, err := Sunset(refined, tm)
+	if err != nil {
+		t.Fatalf("Sunset() error = %v", err)
+	}
+
+	p := DayPhaseAt(loc, sunset)
+	if p.Phase != EveningGoldenHour {
+		t.Fatalf("DayPhaseAt(sunset) = %s, want %s", p.Phase, EveningGoldenHour)
+	}
+	if d := p.Start.Sub(start); d < -time.Millisecond || d > time.Millisecond {
+		t.Errorf("evening golden hour starts %s, want %s", p.Start, start)
+	}
+	if d := p.End.Sub(end); d < -time.Millisecond || d > time.Millisecond {
+		t.Errorf("evening golden hour ends %s, want %s", p.End, end)
+	}
+}
+
+// TestDayPhaseAt_Sunset checks the phase on either side of sunset and of the
+// end of the golden hour with each algorithm, at the high latitude where the
+// sunrise equation's events are furthest from the sun's position
+func TestDayPhaseAt_Sunset(t *testing.T) {
+	tests := []struct {
+		algorithm Algorithm
+		refined   bool
+		margin    time.Duration
+	}{
+		{SunriseEquation, false, time.Millisecond},
+		{SunriseEquation, true, time.Millisecond},
+		{NOAA, false, 10 * time.Second},
+		{NOAA, true, time.Millisecond},
+		{SPA, false, 10 * time.Second},
+		{SPA, true, time.Millisecond},
+	}
+
+	tm := NewTime(2024, time.May, 1)
+	for _, tt := range tests {
+		loc := NewLocation(69.65, 18.96).WithAlgorithm(tt.algorithm).WithRefinement(tt.refined) // Tromsø
+		sunset, err := Sunset(loc, tm)
+		if err != nil {
+			t.Fatalf("Sunset() error = %v", err)
+		}
+		goldenEnd, err := DuskErr(loc, tm, GoldenHour)
+		if err != nil {
+			t.Fatalf("DuskErr() error = %v", err)
+		}
+
+		for _, c := range []struct {
+			name          string
+			when          time.Time
+			before, after DayPhase
+		}{
+			{"sunset", sunset, EveningGoldenHour, EveningGoldenHour},
+			{"golden hour dusk", goldenEnd, EveningGoldenHour, EveningCivilTwilight},
+		} {
+			if p := DayPhaseAt(loc, c.when.Add(-tt.margin)); p.Phase != c.before {
+				t.Errorf("%s refined=%t: DayPhaseAt(just before %s) = %s, want %s", tt.algorithm, tt.refined, c.name, p.Phase, c.before)
+			}
+			if p := DayPhaseAt(loc, c.when.Add(tt.margin)); p.Phase != c.after {
+				t.Errorf("%s refined=%t: DayPhaseAt(just after %s) = %s, want %s", tt.algorithm, tt.refined, c.name, p.Phase, c.after)
+			}
+		}
+	}
+}
+
+// TestDayPhaseAt_Polar checks the polar night, when the golden hour around
+// noon turns from morning to evening at solar noon, and the midnight sun, when
+// the day has no start or end
+func TestDayPhaseAt_Polar(t *testing.T) {
+	loc := NewLocation(69.65, 18.96) // Tromsø
+	noon := SolarNoon(loc, NewTime(2024, time.December, 21))
+
+	p := DayPhaseAt(loc, noon.Time.Add(-time.Hour))
+	if p.Phase != MorningGoldenHour {
+		t.Fatalf("DayPhaseAt(before noon) = %s, want %s", p.Phase, MorningGoldenHour)
+	}
+	if d := p.End.Sub(noon.Time); d < -time.Minute || d > time.Minute {
+		t.Errorf("morning golden hour ends %s, solar noon %s", p.End, noon.Time)
+	}
+	if next := DayPhaseAt(loc, p.End.Add(time.Second)); next.Phase != EveningGoldenHour {
+		t.Errorf("DayPhaseAt(after noon) = %s, want %s", next.Phase, EveningGoldenHour)
+	}
+
+	svalbard := NewLocation(78.22, 15.65) // Longyearbyen
+	midnight := SolarMidnight(svalbard, NewTime(2024, time.June, 21))
+	p = DayPhaseAt(svalbard, midnight.Time)
+	if p.Phase != Day || !p.Start.IsZero() || !p.End.IsZero() {
+		t.Errorf("DayPhaseAt(midnight sun) = %s, %s to %s, want Day without bounds", p.Phase, p.Start, p.End)
+	}
+}
+
+// TestDayPhaseAt_BriefTwilight checks that a twilight shorter than the
+// sampling step, when the sun only just climbs above -18° at noon, is not
+// stepped over
+func TestDayPhaseAt_BriefTwilight(t *testing.T) {
+	loc := NewLocation(84.5598, 0).WithRefinement(true)
+	day := time.Date(2024, time.December, 21, 0, 0, 0, 0, time.UTC)
+	crossings := ElevationCrossings(loc, AstronomicalTwilightAngle, day, day.AddDate(0, 0, 1))
+	if len(crossings) != 2 || crossings[1].Time.Sub(crossings[0].Time) >= 10*time.Minute {
+		t.Fatalf("crossings of -18° = %v, want two less than a step apart", crossings)
+	}
+
+	// The samples, ten minutes apart from 06:03, all miss the twilight
+	p := DayPhaseAt(loc, day.Add(6*time.Hour+3*time.Minute))
+	if p.Phase != Night {
+		t.Fatalf("DayPhaseAt(morning) = %s, want %s", p.Phase, Night)
+	}
+	if d := p.End.Sub(crossings[0].Time); d < -time.Second || d > time.Second {
+		t.Errorf("night ends %s, want %s", p.End, crossings[0].Time)
+	}
+	if next := DayPhaseAt(loc, p.End.Add(time.Second)); next.Phase != MorningAstronomicalTwilight {
+		t.Errorf("DayPhaseAt(after night) = %s, want %s", next.Phase, MorningAstronomicalTwilight)
+	}
+
+	// The night after the twilight starts at its end
+	p = DayPhaseAt(loc, day.Add(18*time.Hour+3*time.Minute))
+	if d := p.Start.Sub(crossings[1].Time); p.Phase != Night || d < -time.Second || d > time.Second {
+		t.Errorf("DayPhaseAt(evening) = %s from %s, want %s from %s", p.Phase, p.Start, Night, crossings[1].Time)
+	}
+}
+
+func TestDayPhase_String(t *testing.T) {
+	for phase := Night; phase <= EveningAstronomicalTwilight; phase++ {
+		if phase.String() == "Night" && phase != Night {
+			t.Errorf("DayPhase(%d).String() = Night", int(phase))
+		}
+	}
+	if MorningGoldenHour.String() != "MorningGoldenHour" || EveningNauticalTwilight.String() != "EveningNauticalTwilight" {
+		t.Errorf("String() = %q, %q", MorningGoldenHour, EveningNauticalTwilight)
+	}
+}
+
+// BenchmarkDayPhaseAt benchmarks classifying an instant and finding its bounds
+func BenchmarkDayPhaseAt(b *testing.B) {
+	loc := NewLocation(40.7128, -74.0060)
+	when := time.Date(2024, time.June, 21, 0, 30, 0, 0, time.UTC)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = DayPhaseAt(loc, when)
+	}
+}
@@ -0,0 +1,180 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// SunPosition describes where the sun is at a given moment, together with the
+// intermediate quantities used to locate it. All angles are in degrees.
+//
+// The equatorial coordinates are geocentric: they locate the sun as seen from
+// the center of the Earth. The horizontal coordinates are geocentric too,
+// except with SPA, whose elevation and azimuth are already corrected for the
+// observer's parallax. Topocentric holds the coordinates seen from the
+// observer's position on the surface for every algorithm.
+type SunPosition struct {
+	// Elevation is the true (geometric) angle of the sun's center above the
+	// horizon, without atmospheric refraction. It is negative when the sun is
+	// below the horizon.
+	Elevation float64
+
+	// ApparentElevation is the elevation at which the sun is seen: the true
+	// elevation raised by atmospheric refraction, computed with the location's
+	// refraction model, pressure and temperature.
+	ApparentElevation float64
+
+	// Azimuth is the compass direction of the sun, measured clockwise from
+	// true north (0° = North, 90° = East, 180° = South, 270° = West).
+	Azimuth float64
+
+	// Zenith is the angle between the sun and the point directly overhead,
+	// equal to 90° minus the elevation.
+	Zenith float64
+
+	// HourAngle is the angular distance of the sun from the local meridian,
+	// negative in the morning and positive in the afternoon (-180° to 180°).
+	HourAngle float64
+
+	// Declination is the angle of the sun north (positive) or south
+	// (negative) of the celestial equator.
+	Declination float64
+
+	// RightAscension is the angle of the sun measured eastward along the
+	// celestial equator from the vernal equinox (0° to 360°).
+	RightAscension float64
+
+	// EquationOfTime is apparent solar time minus mean solar time, in
+	// minutes. A positive value means a sundial is ahead of the clock.
+	EquationOfTime float64
+
+	// EclipticLongitude is the apparent longitude of the sun along the
+	// ecliptic, measured from the vernal equinox (0° to 360°).
+	EclipticLongitude float64
+
+	// Distance is the distance between the Earth and the sun in astronomical
+	// units.
+	Distance float64
+
+	// AngularRadius is the apparent radius (semidiameter) of the sun's disk
+	// at its current distance.
+	AngularRadius float64
+
+	// Irradiance is the solar irradiance at the top of the atmosphere on a
+	// surface facing the sun, in watts per square meter: the SolarConstant
+	// scaled by the inverse square of the distance.
+	Irradiance float64
+
+	// Topocentric is the position of the sun seen from the observer's
+	// position on the WGS84 ellipsoid, at the observer's height, corrected for
+	// the solar parallax.
+	Topocentric Topocentric
+}
+
+// Position calculates the position of the sun at a given moment at the
+// specified location. The whole ephemeris chain is computed once, so this is
+// cheaper than calling Elevation and Azimuth separately.
+//
+// The moment is read in the location's time scale, UTC unless set with
+// Location.WithTimeScale. The SPA evaluates the ephemeris in Terrestrial Time,
+// ΔT after the universal time, and stays accurate for historical and future
+// dates; the NOAA and sunrise equation algorithms, like their references,
+// evaluate it at the universal time.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - when: The moment in time to calculate the position (in UTC, or in the location's time scale)
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
+//
+// Returns:
+//   - The sun's true and apparent horizontal coordinates, equatorial
+//     coordinates, equation of time, ecliptic longitude and distance, and its
+//     topocentric coordinates
+//
+// Example:
+//
+//	loc := solar.NewLocation(43.65, -79.38)
+//	pos := solar.Position(loc, time.Date(2024, time.June, 21, 17, 0, 0, 0, time.UTC))
+//	fmt.Printf("elevation %.2f°, azimuth %.2f°, declination %.2f°\n",
+//	    pos.Elevation, pos.Azimuth, pos.Declination)
+func Position(loc Location, when time.Time, algorithm ...Algorithm) SunPosition {
+	when = ConvertTimeScale(when, loc.TimeScale(), UTC)
+
+	var pos SunPosition
+	switch selectAlgorithm(loc, algorithm) {
+	case SPA:
+		pos = spaAt(loc.Latitude(), loc.Longitude(), loc.Height(), when).sunPosition()
+	case NOAA:
+		pos = noaaSunPosition(loc.Latitude(), loc.Longitude(), when)
+	default:
+		pos = positionInternal(loc.Latitude(), loc.Longitude(), loc.SecularTerms(), when)
+	}
+
+	pos.ApparentElevation = pos.Elevation + loc.Refraction().Refraction(pos.Elevation, loc.Pressure(), loc.Temperature())
+	pos.AngularRadius = angularRadius(pos.Distance)
+	pos.Irradiance = irradiance(pos.Distance)
+	pos.Topocentric = topocentricPosition(loc.Latitude(), loc.Height(), pos)
+	return pos
+}
+
+// positionInternal computes the sun's position with the sunrise equation,
+// using the secular orbital elements if secular is true.
+func positionInternal(latitude, longitude float64, secular bool, when time.Time) SunPosition {
+	var (
+		d                 = meanSolarNoonInternal(longitude, when.Year(), when.Month(), when.Day())
+		sun               = sunriseEquation(d, secular)
+		meanAnomaly       = sun.meanAnomaly
+		eclipticLongitude = sun.eclipticLongitude
+		transit           = sun.transit
+		declination       = sun.declination
+		frac              = TimeToJulianDay(when) - transit
+		hourAngle         = 2 * math.Pi * frac
+		latRad            = latitude * Degree
+		declRad           = declination * Degree
+		// https://solarsena.com/solar-elevation-angle-altitude/
+		firstPart  = math.Sin(latRad) * math.Sin(declRad)
+		secondPart = math.Cos(latRad) * math.Cos(declRad) * math.Cos(hourAngle)
+		elevation  = math.Asin(firstPart+secondPart) / Degree
+	)
+
+	// Calculate azimuth using spherical trigonometry
+	// Formula: cos(Az) = (sin(δ) * cos(φ) - cos(δ) * sin(φ) * cos(H)) / cos(h)
+	// Where:
+	//   Az = azimuth angle
+	//   δ = declination
+	//   φ = latitude
+	//   H = hour angle
+	//   h = elevation
+	cosAzimuth := (math.Sin(declRad)*math.Cos(latRad) - math.Cos(declRad)*math.Sin(latRad)*math.Cos(hourAngle)) /
+		math.Cos(elevation*Degree)
+	azimuth := math.Acos(cosAzimuth) / Degree
+
+	// Adjust for afternoon (hour angle >= 0 means afternoon/evening)
+	if hourAngle >= 0 {
+		azimuth = FullCircleDegrees - azimuth
+	}
+
+	var (
+		lambdaRad      = eclipticLongitude * Degree
+		obliquityRad   = sun.obliquity * Degree
+		rightAscension = math.Atan2(math.Sin(lambdaRad)*math.Cos(obliquityRad), math.Cos(lambdaRad)) / Degree
+	)
+
+	return SunPosition{
+		Elevation:         elevation,
+		Azimuth:           azimuth,
+		Zenith:            90 - elevation,
+		HourAngle:         signedDegrees(hourAngle / Degree),
+		Declination:       declination,
+		RightAscension:    limitDegrees(rightAscension),
+		EquationOfTime:    (d - transit) * 1440,
+		EclipticLongitude: eclipticLongitude,
+		Distance:          sunriseEquationDistance(meanAnomaly),
+	}
+}
+
+// signedDegrees reduces an angle in degrees to the range [-180, 180).
+func signedDegrees(degrees float64) float64 {
+	v := limitDegrees(degrees + HalfCircleDegrees)
+	return v - HalfCircleDegrees
+}
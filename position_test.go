@@ -0,0 +1,141 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestPosition_MatchesElevationAzimuth checks that Position agrees with the
+// single-value functions for every algorithm.
+func TestPosition_MatchesElevationAzimuth(t *testing.T) {
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		for _, tt := range dataAzimuth {
+			t.Run(algorithm.String()+"/"+tt.name, func(t *testing.T) {
+				loc := NewLocation(tt.latitude, tt.longitude)
+				pos := Position(loc, tt.when, algorithm)
+
+				if e := Elevation(loc, tt.when, algorithm); pos.Elevation != e {
+					t.Errorf("Position().Elevation = %f, Elevation() = %f", pos.Elevation, e)
+				}
+				if a := Azimuth(loc, tt.when, algorithm); pos.Azimuth != a {
+					t.Errorf("Position().Azimuth = %f, Azimuth() = %f", pos.Azimuth, a)
+				}
+				if !AlmostEqual(pos.Zenith, 90-pos.Elevation, 1e-12) {
+					t.Errorf("Zenith = %f, want %f", pos.Zenith, 90-pos.Elevation)
+				}
+				if pos.HourAngle < -180 || pos.HourAngle >= 180 {
+					t.Errorf("HourAngle = %f, want [-180, 180)", pos.HourAngle)
+				}
+				if pos.RightAscension < 0 || pos.RightAscension >= 360 {
+					t.Errorf("RightAscension = %f, want [0, 360)", pos.RightAscension)
+				}
+			})
+		}
+	}
+}
+
+// TestPosition_Algorithms checks every algorithm against the SPA reference
+// to within the accuracy of the sunrise equation.
+func TestPosition_Algorithms(t *testing.T) {
+	loc := NewLocation(spaReference.latitude, spaReference.longitude)
+	ref := Position(loc, spaReference.when, SPA)
+
+	// Values from the SPA report
+	if !AlmostEqual(ref.Declination, -9.31434, 1e-4) {
+		t.Errorf("SPA Declination = %f, want -9.31434", ref.Declination)
+	}
+	if !AlmostEqual(ref.RightAscension, 202.22741, 1e-4) {
+		t.Errorf("SPA RightAscension = %f, want 202.22741", ref.RightAscension)
+	}
+	if !AlmostEqual(ref.HourAngle, 11.1059, 1e-3) {
+		t.Errorf("SPA HourAngle = %f, want 11.1059", ref.HourAngle)
+	}
+	if !AlmostEqual(ref.EquationOfTime, 14.6415, 1e-3) {
+		t.Errorf("SPA EquationOfTime = %f, want 14.6415", ref.EquationOfTime)
+	}
+	if !AlmostEqual(ref.Distance, 0.9965423, 1e-6) {
+		t.Errorf("SPA Distance = %f, want 0.9965423", ref.Distance)
+	}
+
+	tests := []struct {
+		algorithm Algorithm
+		angle     float64 // degrees
+		minutes   float64 // equation of time
+	}{
+		{NOAA, 0.01, 0.05},
+		{SunriseEquation, 0.5, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm.String(), func(t *testing.T) {
+			pos := Position(loc, spaReference.when, tt.algorithm)
+			checks := []struct {
+				name      string
+				got, want float64
+				tolerance float64
+			}{
+				{"Elevation", pos.Elevation, ref.Elevation, tt.angle},
+				{"Azimuth", pos.Azimuth, ref.Azimuth, tt.angle},
+				{"HourAngle", pos.HourAngle, ref.HourAngle, tt.angle},
+				{"Declination", pos.Declination, ref.Declination, tt.angle},
+				{"RightAscension", pos.RightAscension, ref.RightAscension, tt.angle},
+				{"EclipticLongitude", pos.EclipticLongitude, ref.EclipticLongitude, tt.angle},
+				{"EquationOfTime", pos.EquationOfTime, ref.EquationOfTime, tt.minutes},
+				{"Distance", pos.Distance, ref.Distance, 0.0005},
+			}
+			for _, c := range checks {
+				if math.Abs(c.got-c.want) > c.tolerance {
+					t.Errorf("%s = %f, SPA = %f (±%g)", c.name, c.got, c.want, c.tolerance)
+				}
+			}
+		})
+	}
+}
+
+// TestPosition_HourAngleSign checks that the hour angle is negative before
+// solar noon and positive after.
+func TestPosition_HourAngleSign(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	morning := time.Date(2024, time.June, 21, 13, 0, 0, 0, time.UTC)
+	afternoon := time.Date(2024, time.June, 21, 21, 0, 0, 0, time.UTC)
+
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			if ha := Position(loc, morning, algorithm).HourAngle; ha >= 0 {
+				t.Errorf("morning HourAngle = %f, want negative", ha)
+			}
+			if ha := Position(loc, afternoon, algorithm).HourAngle; ha <= 0 {
+				t.Errorf("afternoon HourAngle = %f, want positive", ha)
+			}
+		})
+	}
+}
+
+func TestSignedDegrees(t *testing.T) {
+	tests := []struct {
+		in, out float64
+	}{
+		{0, 0},
+		{180, -180},
+		{190, -170},
+		{-190, 170},
+		{359, -1},
+	}
+	for _, tt := range tests {
+		if v := signedDegrees(tt.in); !AlmostEqual(v, tt.out, 1e-9) {
+			t.Errorf("signedDegrees(%f) = %f, want %f", tt.in, v, tt.out)
+		}
+	}
+}
+
+// BenchmarkPosition benchmarks the combined position calculation
+func BenchmarkPosition(b *testing.B) {
+	loc := NewLocation(40.7128, -74.0060)
+	when := time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = Position(loc, when)
+	}
+}
@@ -0,0 +1,115 @@
+package solar
+
+import "math"
+
+// RefractionModel selects the formula used to compute atmospheric refraction,
+// the amount by which the atmosphere raises the sun above its true (geometric)
+// elevation.
+//
+// A model is chosen per observer with Location.WithRefraction. It is used for
+// the apparent elevation and for the refraction at the horizon that decides
+// when the sun rises and sets.
+type RefractionModel int
+
+const (
+	// RefractionSPA uses the formula of the NREL Solar Position Algorithm:
+	// Sæmundsson's formula scaled for the observer's pressure and temperature.
+	// This is the default.
+	RefractionSPA RefractionModel = iota
+
+	// RefractionNone applies no refraction: the apparent elevation equals the
+	// true elevation, and the sun rises when its upper limb reaches the
+	// geometric horizon.
+	RefractionNone
+
+	// RefractionBennett uses G. G. Bennett's formula (1982) for a standard
+	// atmosphere, which gives the refraction for an apparent elevation and is
+	// solved iteratively for the true elevation. It ignores the observer's
+	// pressure and temperature.
+	RefractionBennett
+
+	// RefractionSaemundsson uses Þ. Sæmundsson's formula (1986) for a standard
+	// atmosphere, which gives the refraction directly for a true elevation. It
+	// ignores the observer's pressure and temperature.
+	RefractionSaemundsson
+)
+
+// String returns the name of the refraction model.
+func (m RefractionModel) String() string {
+	switch m {
+	case RefractionNone:
+		return "None"
+	case RefractionBennett:
+		return "Bennett"
+	case RefractionSaemundsson:
+		return "Saemundsson"
+	default:
+		return "SPA"
+	}
+}
+
+// Refraction returns the atmospheric refraction, in degrees, for the sun at the
+// given true elevation (degrees). The pressure (millibars) and temperature (°C)
+// are only used by RefractionSPA. No refraction is applied once the sun is
+// entirely below the horizon, where it cannot be seen.
+//
+// Example:
+//
+//	r := solar.RefractionBennett.Refraction(0, solar.StandardPressure, solar.StandardTemperature)
+//	// r ≈ 0.48°: the sun's center is seen on the horizon when it is 0.48° below it
+func (m RefractionModel) Refraction(elevation, pressure, temperature float64) float64 {
+	if m == RefractionNone || elevation < -(HorizonRefraction+SolarSemidiameter) {
+		return 0
+	}
+
+	switch m {
+	case RefractionBennett:
+		// Bennett's formula takes the apparent elevation, which is found by
+		// fixed-point iteration from the true elevation
+		apparent := elevation
+		for range 20 {
+			next := elevation + bennettRefraction(apparent)
+			if math.Abs(next-apparent) < 1e-9 {
+				return next - elevation
+			}
+			apparent = next
+		}
+		return apparent - elevation
+	case RefractionSaemundsson:
+		return saemundssonRefraction(elevation)
+	default:
+		return refractionScale(pressure, temperature) * saemundssonRefraction(elevation)
+	}
+}
+
+// horizonRefraction returns the refraction, in degrees, assumed at the horizon
+// for sunrise and sunset.
+func (m RefractionModel) horizonRefraction(pressure, temperature float64) float64 {
+	switch m {
+	case RefractionNone:
+		return 0
+	case RefractionBennett, RefractionSaemundsson:
+		return HorizonRefraction
+	default:
+		return HorizonRefraction * refractionScale(pressure, temperature)
+	}
+}
+
+// bennettRefraction returns the refraction, in degrees, for the apparent
+// elevation (degrees) in a standard atmosphere.
+func bennettRefraction(apparent float64) float64 {
+	return 1 / (60 * math.Tan((apparent+7.31/(apparent+4.4))*Degree))
+}
+
+// saemundssonRefraction returns the refraction, in degrees, for the true
+// elevation (degrees) in a standard atmosphere.
+func saemundssonRefraction(elevation float64) float64 {
+	return 1.02 / (60 * math.Tan((elevation+10.3/(elevation+5.11))*Degree))
+}
+
+// refractionScale returns the factor by which refraction in an atmosphere at
+// the given pressure (millibars) and temperature (°C) differs from refraction
+// in the standard atmosphere.
+func refractionScale(pressure, temperature float64) float64 {
+	return (pressure / StandardPressure) * ((273 + StandardTemperature) / (273 + temperature))
+}
@@ -0,0 +1,168 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRefractionModel_Refraction(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       RefractionModel
+		elevation   float64
+		pressure    float64
+		temperature float64
+		want        float64
+		tolerance   float64
+	}{
+		{"SPA horizon", RefractionSPA, 0, StandardPressure, StandardTemperature, 0.4830, 1e-4},
+		{"SPA zenith", RefractionSPA, 90, StandardPressure, StandardTemperature, 0, 1e-4},
+		{"SPA reference", RefractionSPA, 39.872046, 820, 11, 0.016332, 1e-6},
+		{"SPA no atmosphere", RefractionSPA, 10, 0, StandardTemperature, 0, 0},
+		{"SPA below the horizon", RefractionSPA, -1, StandardPressure, StandardTemperature, 0, 0},
+		{"None", RefractionNone, 0, StandardPressure, StandardTemperature, 0, 0},
+		{"Bennett horizon", RefractionBennett, 0, StandardPressure, StandardTemperature, 0.4822, 1e-4},
+		{"Bennett 45°", RefractionBennett, 45, StandardPressure, StandardTemperature, 0.0166, 1e-4},
+		{"Bennett ignores pressure", RefractionBennett, 0, 500, 30, 0.4822, 1e-4},
+		{"Saemundsson horizon", RefractionSaemundsson, 0, StandardPressure, StandardTemperature, 0.4830, 1e-4},
+		{"Saemundsson 45°", RefractionSaemundsson, 45, StandardPressure, StandardTemperature, 0.0169, 1e-4},
+		{"Saemundsson ignores pressure", RefractionSaemundsson, 0, 500, 30, 0.4830, 1e-4},
+		{"Saemundsson below the horizon", RefractionSaemundsson, -2, StandardPressure, StandardTemperature, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.model.Refraction(tt.elevation, tt.pressure, tt.temperature)
+			if math.Abs(got-tt.want) > tt.tolerance {
+				t.Errorf("%s.Refraction(%v) = %.6f, want %.6f", tt.model, tt.elevation, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRefractionBennett_Inverse checks that the iterative solution inverts
+// Bennett's formula, which is defined for the apparent elevation.
+func TestRefractionBennett_Inverse(t *testing.T) {
+	for _, apparent := range []float64{0, 0.5, 2, 10, 45, 80} {
+		r := bennettRefraction(apparent)
+		if got := RefractionBennett.Refraction(apparent-r, StandardPressure, StandardTemperature); !AlmostEqual(got, r, 1e-6) {
+			t.Errorf("Refraction(%v) = %.7f, want %.7f", apparent-r, got, r)
+		}
+	}
+
+	// About 34.5 arc minutes at the apparent horizon
+	if r := bennettRefraction(0) * 60; math.Abs(r-34.5) > 0.1 {
+		t.Errorf("bennettRefraction(0) = %.2f', want 34.5'", r)
+	}
+}
+
+func TestRefractionModel_String(t *testing.T) {
+	tests := []struct {
+		model RefractionModel
+		want  string
+	}{
+		{RefractionSPA, "SPA"},
+		{RefractionNone, "None"},
+		{RefractionBennett, "Bennett"},
+		{RefractionSaemundsson, "Saemundsson"},
+	}
+	for _, tt := range tests {
+		if got := tt.model.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestRefractionScale(t *testing.T) {
+	if s := refractionScale(StandardPressure, StandardTemperature); s != 1 {
+		t.Errorf("refractionScale(standard) = %v, want 1", s)
+	}
+	if s := refractionScale(505, StandardTemperature); !AlmostEqual(s, 0.5, 1e-12) {
+		t.Errorf("refractionScale(505 mbar) = %v, want 0.5", s)
+	}
+}
+
+// TestApparentElevation checks the refracted elevation against the SPA
+// report, which gives 39.888378° for 820 mbar and 11 °C.
+func TestApparentElevation(t *testing.T) {
+	ref := spaReference
+	obs := NewObserver(ref.latitude, ref.longitude, ref.height).WithPressure(820).WithTemperature(11)
+
+	apparent := ApparentElevation(obs, ref.when, SPA)
+	if math.Abs(apparent-39.888378) > 0.0003 {
+		t.Errorf("ApparentElevation(SPA) = %.6f, want 39.888378", apparent)
+	}
+
+	// Just after sunrise the true elevation is still well below the horizon,
+	// while the sun's center is seen almost on it
+	loc := NewLocation(43.65, -79.38)
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		sunrise, err := Sunrise(loc, NewTime(2024, time.March, 20), algorithm)
+		if err != nil {
+			t.Fatalf("Sunrise(%s) error = %v", algorithm, err)
+		}
+		when := sunrise.Add(time.Minute)
+		if e := Elevation(loc, when, algorithm); e > -0.5 {
+			t.Errorf("Elevation(%s) after sunrise = %.3f, want below -0.5", algorithm, e)
+		}
+		if e := ApparentElevation(loc, when, algorithm); math.Abs(e) > 0.2 {
+			t.Errorf("ApparentElevation(%s) after sunrise = %.3f, want about 0", algorithm, e)
+		}
+	}
+}
+
+// TestApparentElevation_Models checks that Position reports both elevations
+// and that each model is applied.
+func TestApparentElevation_Models(t *testing.T) {
+	when := time.Date(2024, time.June, 21, 10, 0, 0, 0, time.UTC)
+	loc := NewLocation(51.5072, -0.1276)
+
+	for _, model := range []RefractionModel{RefractionSPA, RefractionNone, RefractionBennett, RefractionSaemundsson} {
+		t.Run(model.String(), func(t *testing.T) {
+			obs := loc.WithRefraction(model)
+			pos := Position(obs, when)
+			want := pos.Elevation + model.Refraction(pos.Elevation, StandardPressure, StandardTemperature)
+			if pos.ApparentElevation != want {
+				t.Errorf("ApparentElevation = %f, want %f", pos.ApparentElevation, want)
+			}
+			if a := ApparentElevation(obs, when); a != pos.ApparentElevation {
+				t.Errorf("ApparentElevation() = %f, Position = %f", a, pos.ApparentElevation)
+			}
+			if e := Elevation(obs, when); e != pos.Elevation {
+				t.Errorf("Elevation() = %f, Position = %f", e, pos.Elevation)
+			}
+		})
+	}
+}
+
+// TestSunrise_RefractionModels checks the effect of the refraction model on
+// the sunrise time.
+func TestSunrise_RefractionModels(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	tm := NewTime(2024, time.March, 20)
+
+	standard, err := Sunrise(loc, tm)
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	geometric, err := Sunrise(loc.WithRefraction(RefractionNone), tm)
+	if err != nil {
+		t.Fatalf("Sunrise(RefractionNone) error = %v", err)
+	}
+
+	// Without refraction the sun must climb another 34', about three minutes
+	if d := geometric.Sub(standard); d < 2*time.Minute || d > 4*time.Minute {
+		t.Errorf("geometric sunrise is %s later, want about 3 minutes", d)
+	}
+
+	for _, model := range []RefractionModel{RefractionBennett, RefractionSaemundsson} {
+		rise, err := Sunrise(loc.WithRefraction(model).WithPressure(700), tm)
+		if err != nil {
+			t.Fatalf("Sunrise(%s) error = %v", model, err)
+		}
+		if d := rise.Sub(standard); d < -time.Second || d > time.Second {
+			t.Errorf("Sunrise(%s) = %s, want %s", model, rise, standard)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// apparentSun returns the declination (degrees) and the equation of time
+// (minutes) of the sun at the Julian day jd using the given algorithm. The
+// sunrise equation uses the secular orbital elements if secular is true.
+func apparentSun(algorithm Algorithm, jd float64, secular bool) (float64, float64) {
+	switch algorithm {
+	case SPA:
+		r := spaPosition(jd, deltaT(julianDayToDecimalYear(jd)), 0, 0, 0)
+		return r.declination, r.equationOfTime
+	case NOAA:
+		r := noaaPosition(jd)
+		return r.declination, r.equationOfTime
+	default:
+		sun := sunriseEquation(jd, secular)
+		return sun.declination, (jd - sun.transit) * 1440
+	}
+}
+
+// eventHourAngle returns the hour angle, in degrees, at which the sun reaches
+// the given elevation. It returns ErrSunNeverRises when the sun stays below
+// the elevation all day and ErrSunNeverSets when it stays above.
+func eventHourAngle(latitude, declination, elevation float64) (float64, error) {
+	var (
+		latitudeRad    = latitude * Degree
+		declinationRad = declination * Degree
+		cosHourAngle   = (math.Sin(elevation*Degree) - math.Sin(latitudeRad)*math.Sin(declinationRad)) /
+			(math.Cos(latitudeRad) * math.Cos(declinationRad))
+	)
+
+	if cosHourAngle > 1 {
+		return 0, ErrSunNeverRises
+	}
+	if cosHourAngle < -1 {
+		return 0, ErrSunNeverSets
+	}
+	return math.Acos(cosHourAngle) / Degree, nil
+}
+
+// sunFunc returns the declination (degrees) and the equation of time (minutes)
+// of the sun at the Julian day jd.
+type sunFunc func(jd float64) (declination, equationOfTime float64)
+
+// eventJulianDay finds the Julian day at which the sun reaches the given
+// elevation in the morning (rising) or the evening on the UTC day starting at
+// jd0. Like the NOAA Solar Calculator, the event is computed from the sun's
+// position at the start of the day and then recomputed from its position at
+// that first estimate.
+func eventJulianDay(sun sunFunc, latitude, longitude, elevation, jd0 float64, rising bool) (float64, error) {
+	minutes := 0.0
+	for range 2 {
+		declination, equationOfTime := sun(jd0 + minutes/1440)
+		hourAngle, err := eventHourAngle(latitude, declination, elevation)
+		if err != nil {
+			return 0, err
+		}
+		if !rising {
+			hourAngle = -hourAngle
+		}
+		minutes = 720 - 4*(longitude+hourAngle) - equationOfTime
+	}
+	return jd0 + minutes/1440, nil
+}
+
+// timeOfElevationAlgorithm returns the morning and evening times at which the
+// sun reaches the given elevation on the given UTC date using the selected
+// algorithm.
+func timeOfElevationAlgorithm(algorithm Algorithm, latitude, longitude, elevation float64, year int, month time.Month, day int) (morning, evening time.Time, err error) {
+	var (
+		jd0 = TimeToJulianDay(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+		sun = func(jd float64) (float64, float64) {
+			return apparentSun(algorithm, jd, false)
+		}
+	)
+
+	morningJD, err := eventJulianDay(sun, latitude, longitude, elevation, jd0, true)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	eveningJD, err := eventJulianDay(sun, latitude, longitude, elevation, jd0, false)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return JulianDayToTime(morningJD), JulianDayToTime(eveningJD), nil
+}
+
+// instantElevation returns the sun's true elevation (degrees) at the Julian day
+// jd with the sun's coordinates evaluated at that instant. For NOAA and SPA this
+// is the elevation returned by Position. The sunrise equation otherwise
+// evaluates the sun's coordinates once per day, at mean solar noon.
+func instantElevation(algorithm Algorithm, loc Location, jd float64) float64 {
+	elevation, _ := instantSun(algorithm, loc, jd)
+	return elevation
+}
+
+// instantSun returns the sun's true elevation and its hour angle, in degrees,
+// at the Julian day jd, evaluated as by instantElevation. The hour angle is
+// negative in the morning and positive in the afternoon (-180° to 180°).
+func instantSun(algorithm Algorithm, loc Location, jd float64) (elevation, hourAngle float64) {
+	if algorithm != SunriseEquation {
+		pos := Position(loc, JulianDayToTime(jd), algorithm)
+		return pos.Elevation, pos.HourAngle
+	}
+
+	declination, equationOfTime := apparentSun(algorithm, jd, loc.SecularTerms())
+	var (
+		dayFraction   = jd + 0.5 - math.Floor(jd+0.5)
+		trueSolarTime = dayFraction*1440 + equationOfTime + 4*loc.Longitude()
+	)
+	hourAngle = trueSolarTime/4 - HalfCircleDegrees
+	elevation, _ = horizontalCoordinates(loc.Latitude(), declination, hourAngle)
+	return elevation, signedDegrees(hourAngle)
+}
+
+// sunriseEquationSun returns the sun's true elevation and its hour angle, in
+// degrees, at the Julian day jd as the sunrise equation places it on the mean
+// solar day that contains jd: from the declination and transit of that day,
+// from which Sunrise, Dawn and TimeOfElevation compute the day's events. The
+// hour angle is negative in the morning and positive in the afternoon.
+func sunriseEquationSun(loc Location, jd float64) (elevation, hourAngle float64) {
+	// The mean solar day of a date runs half a day either side of its mean
+	// solar noon
+	var (
+		date = JulianDayToTime(jd + loc.Longitude()/LongitudeDivisor)
+		sun  = sunriseEquation(meanSolarNoonInternal(loc.Longitude(), date.Year(), date.Month(), date.Day()), loc.SecularTerms())
+	)
+	hourAngle = (jd - sun.transit) * FullCircleDegrees
+	elevation, _ = horizontalCoordinates(loc.Latitude(), sun.declination, hourAngle)
+	return elevation, signedDegrees(hourAngle)
+}
+
+// refineEvent refines the estimate of the instant at which the sun's true
+// elevation, computed with the given algorithm at the location, crosses the
+// target elevation (degrees). The secant method is applied to the elevation
+// itself, so the sun's position is re-evaluated at each candidate time and the
+// result is the true crossing instant. The estimate is returned unchanged if the
+// iteration does not converge close to it, which can happen when the sun
+// barely reaches the elevation.
+func refineEvent(algorithm Algorithm, loc Location, target float64, estimate time.Time) time.Time {
+	const (
+		step      = 1.0 / 1440 // one minute, in days
+		tolerance = 1e-9       // about 0.1 ms, in days
+		maxDrift  = 0.25       // days
+	)
+
+	elevation := func(jd float64) float64 {
+		return instantElevation(algorithm, loc, jd) - target
+	}
+
+	var (
+		jd0 = TimeToJulianDay(estimate)
+		x0  = jd0
+		f0  = elevation(x0)
+		x1  = jd0 + step
+		f1  = elevation(x1)
+	)
+	for range 20 {
+		if f1 == f0 {
+			break
+		}
+		x2 := x1 - f1*(x1-x0)/(f1-f0)
+		if math.Abs(x2-jd0) > maxDrift {
+			return estimate
+		}
+		if math.Abs(x2-x1) < tolerance {
+			return JulianDayToTime(x2)
+		}
+		x0, f0 = x1, f1
+		x1, f1 = x2, elevation(x2)
+	}
+	if math.Abs(f1) < math.Abs(f0) {
+		return JulianDayToTime(x1)
+	}
+	return JulianDayToTime(x0)
+}
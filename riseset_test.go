@@ -0,0 +1,215 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEventHourAngle(t *testing.T) {
+	// Equator at equinox: the sun's center is on the horizon six hours from noon
+	ha, err := eventHourAngle(0, 0, 0)
+	if err != nil {
+		t.Fatalf("eventHourAngle() error = %v", err)
+	}
+	if !AlmostEqual(ha, 90, 1e-9) {
+		t.Errorf("eventHourAngle(0, 0, 0) = %f, want 90", ha)
+	}
+
+	// Arctic winter: never rises
+	if _, err := eventHourAngle(80, -23.44, 0); err != ErrSunNeverRises {
+		t.Errorf("eventHourAngle(polar night) error = %v, want %v", err, ErrSunNeverRises)
+	}
+
+	// Arctic summer: never sets
+	if _, err := eventHourAngle(80, 23.44, 0); err != ErrSunNeverSets {
+		t.Errorf("eventHourAngle(midnight sun) error = %v, want %v", err, ErrSunNeverSets)
+	}
+}
+
+// TestApparentSun checks that every algorithm agrees on the declination and
+// equation of time to within the accuracy of the sunrise equation.
+func TestApparentSun(t *testing.T) {
+	jd := TimeToJulianDay(time.Date(2024, time.February, 11, 12, 0, 0, 0, time.UTC))
+	refDecl, refEoT := apparentSun(SPA, jd, false)
+
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			decl, eot := apparentSun(algorithm, jd, false)
+			if !AlmostEqual(decl, refDecl, 0.2) {
+				t.Errorf("declination = %f, SPA = %f", decl, refDecl)
+			}
+			if !AlmostEqual(eot, refEoT, 0.5) {
+				t.Errorf("equation of time = %f, SPA = %f", eot, refEoT)
+			}
+		})
+	}
+
+	// Mid-February is near the minimum of the equation of time (about -14 minutes)
+	if !AlmostEqual(refEoT, -14.2, 0.2) {
+		t.Errorf("equation of time = %f, want about -14.2", refEoT)
+	}
+}
+
+func TestTimeOfElevationAlgorithm(t *testing.T) {
+	loc := NewLocation(51.5072, -0.1276)
+	tm := NewTime(2022, time.August, 27)
+
+	// NOAA and SPA agree closely; the single-pass sunrise equation is
+	// within a few minutes of both
+	refMorning, refEvening := TimeOfElevation(loc.WithAlgorithm(SPA), -8.5, tm)
+	tests := []struct {
+		algorithm Algorithm
+		tolerance time.Duration
+	}{
+		{NOAA, 5 * time.Second},
+		{SunriseEquation, 3 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm.String(), func(t *testing.T) {
+			morning, evening := TimeOfElevation(loc.WithAlgorithm(tt.algorithm), -8.5, tm)
+			if d := morning.Sub(refMorning); d < -tt.tolerance || d > tt.tolerance {
+				t.Errorf("morning = %s, SPA = %s", morning, refMorning)
+			}
+			if d := evening.Sub(refEvening); d < -tt.tolerance || d > tt.tolerance {
+				t.Errorf("evening = %s, SPA = %s", evening, refEvening)
+			}
+		})
+	}
+}
+
+// dataRefinement holds locations where the single-evaluation rise and set
+// times are least accurate: high latitudes around the equinoxes.
+var dataRefinement = []struct {
+	name     string
+	location Location
+	date     Time
+}{
+	{"Tromsø equinox", NewLocation(69.65, 18.96), NewTime(2024, time.March, 20)},
+	{"Longyearbyen autumn", NewLocation(78.22, 15.65), NewTime(2024, time.September, 25)},
+	{"McMurdo spring", NewLocation(-77.85, 166.67), NewTime(2024, time.October, 1)},
+	{"Toronto", NewLocation(43.65, -79.38), NewTime(2000, time.January, 1)},
+	{"Quito", NewLocation(-0.18, -78.47), NewTime(2024, time.June, 21)},
+}
+
+// TestRefinement_Crossing checks that refined times are the instants at which
+// each algorithm, evaluated at that instant, puts the sun at the requested
+// elevation.
+func TestRefinement_Crossing(t *testing.T) {
+	for _, tt := range dataRefinement {
+		for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+			t.Run(tt.name+"/"+algorithm.String(), func(t *testing.T) {
+				loc := tt.location.WithAlgorithm(algorithm).WithRefinement(true)
+				horizon := loc.horizon() / Degree
+				elevation := func(when time.Time) float64 {
+					return instantElevation(algorithm, loc, TimeToJulianDay(when))
+				}
+
+				sunrise, sunset, err := SunriseSunset(loc, tt.date)
+				if err != nil {
+					t.Fatalf("SunriseSunset() error = %v", err)
+				}
+				for name, when := range map[string]time.Time{"sunrise": sunrise, "sunset": sunset} {
+					if e := elevation(when); math.Abs(e-horizon) > 1e-5 {
+						t.Errorf("elevation at %s = %.7f, want %.7f", name, e, horizon)
+					}
+				}
+
+				dawn, dusk := DawnDusk(loc, tt.date)
+				for name, when := range map[string]time.Time{"dawn": dawn, "dusk": dusk} {
+					if e := elevation(when); math.Abs(e-CivilTwilightAngle) > 1e-5 {
+						t.Errorf("elevation at %s = %.7f, want %.7f", name, e, CivilTwilightAngle)
+					}
+				}
+
+				morning, evening := TimeOfElevation(loc, 5, tt.date)
+				for name, when := range map[string]time.Time{"morning": morning, "evening": evening} {
+					if e := elevation(when); math.Abs(e-5) > 1e-5 {
+						t.Errorf("elevation at %s = %.7f, want 5", name, e)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestRefinement_Improves checks that the single evaluation misses the
+// crossing at high latitudes around the equinox, where the declination changes
+// fastest, and that refinement moves the time by the expected amount.
+func TestRefinement_Improves(t *testing.T) {
+	tt := dataRefinement[0]
+	horizon := tt.location.horizon() / Degree
+
+	estimate, err := Sunrise(tt.location, tt.date)
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	if e := instantElevation(SunriseEquation, tt.location, TimeToJulianDay(estimate)); math.Abs(e-horizon) < 0.05 {
+		t.Errorf("elevation at the estimated sunrise = %.4f, want well off %.4f", e, horizon)
+	}
+
+	refined, err := Sunrise(tt.location.WithRefinement(true), tt.date)
+	if err != nil {
+		t.Fatalf("Sunrise(refined) error = %v", err)
+	}
+	if d := refined.Sub(estimate); d < 30*time.Second || d > 5*time.Minute {
+		t.Errorf("refinement moved sunrise by %s, want 30s to 5m", d)
+	}
+
+	// The two-pass NOAA and SPA estimates are already within seconds
+	for _, algorithm := range []Algorithm{NOAA, SPA} {
+		estimate, _ := Sunrise(tt.location, tt.date, algorithm)
+		refined, _ := Sunrise(tt.location.WithRefinement(true), tt.date, algorithm)
+		if d := refined.Sub(estimate); d < -10*time.Second || d > 10*time.Second {
+			t.Errorf("%s refinement moved sunrise by %s, want less than 10s", algorithm, d)
+		}
+	}
+}
+
+func TestInstantElevation(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	when := time.Date(2024, time.June, 21, 17, 0, 0, 0, time.UTC)
+	jd := TimeToJulianDay(when)
+
+	for _, algorithm := range []Algorithm{NOAA, SPA} {
+		if e, want := instantElevation(algorithm, loc, jd), Elevation(loc, when, algorithm); e != want {
+			t.Errorf("instantElevation(%s) = %f, want %f", algorithm, e, want)
+		}
+	}
+
+	// The sunrise equation evaluated at the instant stays close to the SPA
+	if e, want := instantElevation(SunriseEquation, loc, jd), Elevation(loc, when, SPA); math.Abs(e-want) > 0.5 {
+		t.Errorf("instantElevation(SunriseEquation) = %f, SPA = %f", e, want)
+	}
+}
+
+func TestRefinement_Options(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	if loc.Refinement() {
+		t.Error("Refinement() = true by default")
+	}
+	if !loc.WithRefinement(true).Refinement() {
+		t.Error("WithRefinement(true).Refinement() = false")
+	}
+
+	// Polar night and midnight sun are still reported
+	polar := NewLocation(69.3321443, -81.6781126).WithRefinement(true)
+	if _, _, err := SunriseSunset(polar, NewTime(2020, time.June, 25)); err != ErrSunNeverSets {
+		t.Errorf("midnight sun error = %v, want %v", err, ErrSunNeverSets)
+	}
+	if dawn, dusk := DawnDusk(NewLocation(51.5072, -0.1276).WithRefinement(true), NewTime(2022, time.June, 21), Astronomical); !dawn.IsZero() || !dusk.IsZero() {
+		t.Errorf("DawnDusk(Astronomical) = %s, %s, want zero times", dawn, dusk)
+	}
+}
+
+// BenchmarkSunriseSunset_Refined benchmarks refined sunrise/sunset
+func BenchmarkSunriseSunset_Refined(b *testing.B) {
+	loc := NewLocation(40.7128, -74.0060).WithRefinement(true)
+	tm := NewTime(2024, time.June, 21)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_, _, _ = SunriseSunset(loc, tm)
+	}
+}
@@ -8,22 +8,31 @@ import (
 
 var (
 	// ErrSunNeverRises is returned when the sun never rises at the given location and date (polar night).
+	// An *ElevationError for a sun that stays below a twilight or other elevation also matches it.
 	ErrSunNeverRises = errors.New("sun never rises at this location on this date")
 	// ErrSunNeverSets is returned when the sun never sets at the given location and date (midnight sun).
+	// An *ElevationError for a sun that stays above a twilight or other elevation also matches it.
 	ErrSunNeverSets = errors.New("sun never sets at this location on this date")
+	// ErrNoEventOnDay is returned when, for a Time with a time zone, the event
+	// occurs on the neighboring days but not within the local civil day.
+	ErrNoEventOnDay = errors.New("event does not occur on this local day")
 )
 
 // Sunrise calculates when the sun will rise on the given day at the specified location.
 //
-// All times are calculated and returned in UTC. To convert to local time, use time.In()
-// with the appropriate timezone.
+// Times are returned in UTC. For a Time created with NewTimeIn or
+// NewTimeFromLocalDateTime, the event falls within that local civil day and is
+// returned in its time zone.
+//
+// Sunrise is when the upper limb clears the horizon; see SunriseSunset.
 //
 // Parameters:
-//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - loc: Location created via NewLocation(), NewObserver() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
 //
 // Returns:
-//   - Sunrise time in UTC
+//   - Sunrise time in UTC, or in the time zone of t
 //   - error if the sun does not rise on this day (e.g., polar night)
 //
 // Example:
@@ -36,22 +45,29 @@ var (
 //	}
 //	// sunrise is in UTC - convert to local time if needed
 //	localTime := sunrise.In(time.Local)
-func Sunrise(loc Location, t Time) (time.Time, error) {
-	rise, _, err := sunriseSunsetInternal(loc.Latitude(), loc.Longitude(), t.Year(), t.Month(), t.Day())
-	return rise, err
+func Sunrise(loc Location, t Time, algorithm ...Algorithm) (time.Time, error) {
+	alg := selectAlgorithm(loc, algorithm)
+	return onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		rise, _, err := sunriseSunsetAt(alg, loc, year, month, day)
+		return rise, err
+	})
 }
 
 // Sunset calculates when the sun will set on the given day at the specified location.
 //
-// All times are calculated and returned in UTC. To convert to local time, use time.In()
-// with the appropriate timezone.
+// Times are returned in UTC. For a Time created with NewTimeIn or
+// NewTimeFromLocalDateTime, the event falls within that local civil day and is
+// returned in its time zone.
+//
+// Sunset is when the upper limb sinks below the horizon; see SunriseSunset.
 //
 // Parameters:
-//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - loc: Location created via NewLocation(), NewObserver() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
 //
 // Returns:
-//   - Sunset time in UTC
+//   - Sunset time in UTC, or in the time zone of t
 //   - error if the sun does not set on this day (e.g., midnight sun)
 //
 // Example:
@@ -64,24 +80,34 @@ func Sunrise(loc Location, t Time) (time.Time, error) {
 //	}
 //	// sunset is in UTC - convert to local time if needed
 //	localTime := sunset.In(time.Local)
-func Sunset(loc Location, t Time) (time.Time, error) {
-	_, set, err := sunriseSunsetInternal(loc.Latitude(), loc.Longitude(), t.Year(), t.Month(), t.Day())
-	return set, err
+func Sunset(loc Location, t Time, algorithm ...Algorithm) (time.Time, error) {
+	alg := selectAlgorithm(loc, algorithm)
+	return onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		_, set, err := sunriseSunsetAt(alg, loc, year, month, day)
+		return set, err
+	})
 }
 
 // SunriseSunset calculates when the sun will rise and when it will set on the
 // given day at the specified location.
 //
-// All times are calculated and returned in UTC. To convert to local time, use time.In()
-// with the appropriate timezone.
+// Times are returned in UTC. For a Time created with NewTimeIn or
+// NewTimeFromLocalDateTime, the event falls within that local civil day and is
+// returned in its time zone.
+//
+// The sun rises and sets when its upper limb touches the horizon, allowing for
+// atmospheric refraction. For an Observer, the horizon dip due to its height and
+// the refraction of its atmosphere are taken into account; Sunrise and Sunset
+// use the same horizon.
 //
 // Parameters:
-//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - loc: Location created via NewLocation(), NewObserver() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
 //
 // Returns:
-//   - sunrise: Sunrise time in UTC
-//   - sunset: Sunset time in UTC
+//   - sunrise: Sunrise time in UTC, or in the time zone of t
+//   - sunset: Sunset time in UTC, or in the time zone of t
 //   - error if the sun does not rise or set (e.g., polar night or midnight sun)
 //
 // Example:
@@ -93,23 +119,55 @@ func Sunset(loc Location, t Time) (time.Time, error) {
 //	    // Handle polar night or midnight sun
 //	}
 //	// Both times are in UTC - convert to local time if needed
-func SunriseSunset(loc Location, t Time) (time.Time, time.Time, error) {
-	return sunriseSunsetInternal(loc.Latitude(), loc.Longitude(), t.Year(), t.Month(), t.Day())
+func SunriseSunset(loc Location, t Time, algorithm ...Algorithm) (time.Time, time.Time, error) {
+	alg := selectAlgorithm(loc, algorithm)
+	if t.zone == nil {
+		return sunriseSunsetAt(alg, loc, t.Year(), t.Month(), t.Day())
+	}
+
+	// The sunrise and sunset of a local day may come from different UTC dates
+	sunrise, err := Sunrise(loc, t, alg)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	sunset, err := Sunset(loc, t, alg)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return sunrise, sunset, nil
+}
+
+// sunriseSunsetAt dispatches to the implementation of the given algorithm,
+// with the sun's center at the location's horizon, and refines the times if
+// the location asks for it.
+func sunriseSunsetAt(algorithm Algorithm, loc Location, year int, month time.Month, day int) (time.Time, time.Time, error) {
+	var (
+		horizon = loc.horizonOn(algorithm, year, month, day)
+		sunrise time.Time
+		sunset  time.Time
+		err     error
+	)
+	if algorithm == SunriseEquation {
+		sunrise, sunset, err = sunriseSunsetInternal(loc.Latitude(), loc.Longitude(), horizon, loc.SecularTerms(), year, month, day)
+	} else {
+		sunrise, sunset, err = timeOfElevationAlgorithm(algorithm, loc.Latitude(), loc.Longitude(), horizon/Degree, year, month, day)
+	}
+	if err != nil || !loc.Refinement() {
+		return sunrise, sunset, err
+	}
+
+	return refineEvent(algorithm, loc, horizon/Degree, sunrise), refineEvent(algorithm, loc, horizon/Degree, sunset), nil
 }
 
 // sunriseSunsetInternal is the internal implementation shared by all public functions.
-func sunriseSunsetInternal(latitude, longitude float64, year int, month time.Month, day int) (time.Time, time.Time, error) {
+func sunriseSunsetInternal(latitude, longitude, horizon float64, secular bool, year int, month time.Month, day int) (time.Time, time.Time, error) {
 	var (
-		d                 = meanSolarNoonInternal(longitude, year, month, day)
-		meanAnomaly       = meanAnomaly(d)
-		equationOfCenter  = equationOfCenter(meanAnomaly)
-		eclipticLongitude = eclipticLongitude(meanAnomaly, equationOfCenter, d)
-		transit           = transit(d, meanAnomaly, eclipticLongitude)
-		declination       = declination(eclipticLongitude)
-		hourAngle         = hourAngle(latitude, declination)
-		frac              = hourAngle / FullCircleDegrees
-		sunrise           = transit - frac
-		sunset            = transit + frac
+		d         = meanSolarNoonInternal(longitude, year, month, day)
+		sun       = sunriseEquation(d, secular)
+		hourAngle = hourAngleAt(latitude, sun.declination, horizon)
+		frac      = hourAngle / FullCircleDegrees
+		sunrise   = sun.transit - frac
+		sunset    = sun.transit + frac
 	)
 
 	// Check for no sunrise, no sunset
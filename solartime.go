@@ -0,0 +1,166 @@
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+var (
+	// meanSolarZone labels the clock reading of a local mean solar time.
+	meanSolarZone = time.FixedZone("LMT", 0)
+
+	// apparentSolarZone labels the clock reading of a local apparent solar
+	// time.
+	apparentSolarZone = time.FixedZone("LAT", 0)
+)
+
+// equationOfTimeAt returns the equation of time, in minutes, at the Julian
+// day jd with the given algorithm, with time-varying orbital elements for the
+// sunrise equation if secular is set.
+func equationOfTimeAt(algorithm Algorithm, jd float64, secular bool) float64 {
+	_, equationOfTime := apparentSun(algorithm, jd, secular)
+	return equationOfTime
+}
+
+// minutesToDuration converts a number of minutes into a time.Duration, rounded
+// to the nanosecond.
+func minutesToDuration(minutes float64) time.Duration {
+	return time.Duration(math.Round(minutes * float64(time.Minute)))
+}
+
+// solarClock returns the clock reading of when, shifted by offset, in the
+// given solar time zone.
+func solarClock(when time.Time, offset time.Duration, zone *time.Location) time.Time {
+	shifted := when.UTC().Add(offset)
+	return time.Date(shifted.Year(), shifted.Month(), shifted.Day(), shifted.Hour(),
+		shifted.Minute(), shifted.Second(), shifted.Nanosecond(), zone)
+}
+
+// clockAsUTC reads the clock of solar, whatever its time zone, as a UTC time.
+func clockAsUTC(solar time.Time) time.Time {
+	return time.Date(solar.Year(), solar.Month(), solar.Day(), solar.Hour(),
+		solar.Minute(), solar.Second(), solar.Nanosecond(), time.UTC)
+}
+
+// EquationOfTime calculates the equation of time at the given instant: the
+// difference between apparent solar time, read from a sundial, and mean solar
+// time, in minutes. It is positive when the sundial is ahead of the clock,
+// from about -14 minutes in mid-February to about +16 minutes in early
+// November.
+//
+// Parameters:
+//   - when: The moment at which to calculate the equation of time
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to SunriseEquation.
+//
+// Returns:
+//   - The equation of time in minutes
+//
+// Example:
+//
+//	eot := solar.EquationOfTime(time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC))
+//	// eot is about +16.4 minutes
+func EquationOfTime(when time.Time, algorithm ...Algorithm) float64 {
+	return equationOfTimeAt(optionalAlgorithm(algorithm), TimeToJulianDay(when), false)
+}
+
+// LocalMeanSolarTime converts an instant into the local mean solar time at the
+// location: UTC shifted by four minutes per degree of longitude east. Mean
+// solar noon is at 12:00 local mean solar time.
+//
+// The result is a clock reading, not the same instant as when: its date and
+// time of day are the mean solar time, in a time zone named "LMT". Use
+// LocalMeanSolarTimeToUTC to convert it back.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - when: The instant to convert
+//
+// Returns:
+//   - The local mean solar time at the location
+//
+// Example:
+//
+//	loc := solar.NewLocation(51.48, -2.59) // Bristol
+//	lmt := solar.LocalMeanSolarTime(loc, time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC))
+//	// lmt is 11:49:38 LMT
+func LocalMeanSolarTime(loc Location, when time.Time) time.Time {
+	return solarClock(when, minutesToDuration(4*loc.Longitude()), meanSolarZone)
+}
+
+// LocalMeanSolarTimeToUTC converts a local mean solar time at the location
+// into the UTC instant. Only the clock reading of lmt is used, whatever its
+// time zone.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - lmt: The local mean solar time, as returned by LocalMeanSolarTime or built with time.Date
+//
+// Returns:
+//   - The instant in UTC
+//
+// Example:
+//
+//	loc := solar.NewLocation(51.48, -2.59)
+//	utc := solar.LocalMeanSolarTimeToUTC(loc, time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC))
+//	// utc is 12:10:22 UTC, the mean solar noon
+func LocalMeanSolarTimeToUTC(loc Location, lmt time.Time) time.Time {
+	return clockAsUTC(lmt).Add(-minutesToDuration(4 * loc.Longitude()))
+}
+
+// LocalApparentSolarTime converts an instant into the local apparent solar
+// time at the location, the time shown by a sundial: the local mean solar time
+// plus the equation of time. The sun crosses the meridian at 12:00 local
+// apparent solar time.
+//
+// The result is a clock reading, not the same instant as when: its date and
+// time of day are the apparent solar time, in a time zone named "LAT". The
+// equation of time is computed with the location's algorithm.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - when: The instant to convert
+//
+// Returns:
+//   - The local apparent solar time at the location
+//
+// Example:
+//
+//	loc := solar.NewLocation(51.48, -2.59)
+//	lat := solar.LocalApparentSolarTime(loc, time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC))
+//	// lat is 12:06 LAT: the sundial is 16 minutes ahead of mean time
+func LocalApparentSolarTime(loc Location, when time.Time) time.Time {
+	minutes := 4*loc.Longitude() + equationOfTimeAt(loc.Algorithm(), TimeToJulianDay(when), loc.SecularTerms())
+	return solarClock(when, minutesToDuration(minutes), apparentSolarZone)
+}
+
+// LocalApparentSolarTimeToUTC converts a local apparent solar time at the
+// location into the UTC instant. Only the clock reading of lat is used,
+// whatever its time zone. Converting 12:00 of a date gives the instant of true
+// solar noon, when the sun crosses the meridian.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - lat: The local apparent solar time, as returned by LocalApparentSolarTime or built with time.Date
+//
+// Returns:
+//   - The instant in UTC
+//
+// Example:
+//
+//	loc := solar.NewLocation(51.48, -2.59)
+//	noon := solar.LocalApparentSolarTimeToUTC(loc, time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC))
+//	// noon is 11:54 UTC, the true solar noon in Bristol
+func LocalApparentSolarTimeToUTC(loc Location, lat time.Time) time.Time {
+	var (
+		mean     = LocalMeanSolarTimeToUTC(loc, lat)
+		jd       = TimeToJulianDay(mean)
+		estimate = jd
+	)
+
+	// The equation of time changes by at most half a minute a day, so a few
+	// fixed-point iterations converge far below a nanosecond
+	for range 4 {
+		estimate = jd - equationOfTimeAt(loc.Algorithm(), estimate, loc.SecularTerms())/1440
+	}
+	return mean.Add(minutesToDuration((estimate - jd) * 1440))
+}
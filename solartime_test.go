@@ -0,0 +1,110 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestEquationOfTime checks the extremes of the equation of time, which every
+// algorithm reproduces to within a few seconds.
+func TestEquationOfTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		when    time.Time
+		minutes float64
+	}{
+		{"February minimum", time.Date(2024, time.February, 11, 12, 0, 0, 0, time.UTC), -14.2},
+		{"May maximum", time.Date(2024, time.May, 14, 12, 0, 0, 0, time.UTC), 3.66},
+		{"July minimum", time.Date(2024, time.July, 26, 12, 0, 0, 0, time.UTC), -6.54},
+		{"November maximum", time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC), 16.45},
+	}
+	for _, tt := range tests {
+		for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+			t.Run(tt.name+"/"+algorithm.String(), func(t *testing.T) {
+				if eot := EquationOfTime(tt.when, algorithm); !AlmostEqual(eot, tt.minutes, 0.25) {
+					t.Errorf("EquationOfTime() = %.2f, want %.2f", eot, tt.minutes)
+				}
+			})
+		}
+	}
+
+	// The default is the sunrise equation, and agrees with Position
+	when := tests[0].when
+	if eot, want := EquationOfTime(when), EquationOfTime(when, SunriseEquation); eot != want {
+		t.Errorf("EquationOfTime() = %f, want %f", eot, want)
+	}
+	if eot, want := EquationOfTime(when, SPA), Position(NewLocation(0, 0), when, SPA).EquationOfTime; eot != want {
+		t.Errorf("EquationOfTime(SPA) = %f, Position = %f", eot, want)
+	}
+}
+
+func TestLocalMeanSolarTime(t *testing.T) {
+	loc := NewLocation(51.48, -2.59)
+	when := time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)
+
+	lmt := LocalMeanSolarTime(loc, when)
+	want := time.Date(2024, time.June, 21, 11, 49, 38, 400000000, meanSolarZone)
+	if !lmt.Equal(want) || lmt.Location().String() != "LMT" {
+		t.Errorf("LocalMeanSolarTime() = %s, want %s", lmt, want)
+	}
+	if back := LocalMeanSolarTimeToUTC(loc, lmt); !back.Equal(when) {
+		t.Errorf("LocalMeanSolarTimeToUTC() = %s, want %s", back, when)
+	}
+
+	// Mean solar noon is 12:00 local mean solar time, whatever the date line
+	for _, longitude := range []float64{-179.5, -79.38, 0, 139.69, 179.5} {
+		loc := NewLocation(0, longitude)
+		noon := MeanSolarNoon(loc, NewTime(2024, time.March, 1))
+		want := time.Date(2024, time.March, 1, 12, 0, 0, 0, meanSolarZone)
+		if got := LocalMeanSolarTime(loc, noon); got.Sub(want).Abs() > 100*time.Microsecond {
+			t.Errorf("longitude %.2f: mean solar noon is %s", longitude, got)
+		}
+	}
+}
+
+// TestLocalApparentSolarTime checks that the sun is on the meridian at 12:00
+// local apparent solar time for every algorithm, and that the conversions
+// invert each other.
+func TestLocalApparentSolarTime(t *testing.T) {
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			loc := NewLocation(51.48, -2.59).WithAlgorithm(algorithm)
+			for _, month := range []time.Month{time.February, time.May, time.July, time.November} {
+				lat := time.Date(2024, month, 10, 12, 0, 0, 0, time.UTC)
+				noon := LocalApparentSolarTimeToUTC(loc, lat)
+
+				if back := LocalApparentSolarTime(loc, noon); back.Sub(lat.In(apparentSolarZone)).Abs() > 100*time.Microsecond {
+					t.Errorf("%s: round trip = %s, want %s", month, back, lat)
+				}
+				if algorithm == SunriseEquation {
+					continue // the sunrise equation evaluates the sun once per day
+				}
+				if ha := Position(loc, noon).HourAngle; math.Abs(ha) > 0.005 {
+					t.Errorf("%s: hour angle at apparent noon = %f, want 0", month, ha)
+				}
+			}
+
+			when := time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC)
+			lat := LocalApparentSolarTime(loc, when)
+			lmt := LocalMeanSolarTime(loc, when)
+			if d := lat.Sub(lmt).Minutes(); !AlmostEqual(d, EquationOfTime(when, algorithm), 1e-6) {
+				t.Errorf("apparent - mean = %f min, want the equation of time", d)
+			}
+			if lat.Location().String() != "LAT" {
+				t.Errorf("zone = %s, want LAT", lat.Location())
+			}
+		})
+	}
+}
+
+// BenchmarkLocalApparentSolarTimeToUTC benchmarks the inverse conversion
+func BenchmarkLocalApparentSolarTimeToUTC(b *testing.B) {
+	loc := NewLocation(51.48, -2.59)
+	lat := time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = LocalApparentSolarTimeToUTC(loc, lat)
+	}
+}
@@ -0,0 +1,58 @@
+package solar
+
+import "math"
+
+// sunriseEquationResult holds the quantities computed by the sunrise equation
+// for a single Julian day. All angles are in degrees.
+type sunriseEquationResult struct {
+	meanAnomaly       float64
+	equationOfCenter  float64
+	eclipticLongitude float64
+	obliquity         float64
+	declination       float64
+	transit           float64 // Julian day of the solar transit
+}
+
+// sunriseEquation evaluates the sunrise equation at the Julian day d, which is
+// normally the mean solar noon of a day.
+//
+// The fast path uses the constant obliquity, equation of center and equation of
+// time coefficients, which are accurate around J2000. With secular true, the
+// obliquity, eccentricity, equation of center and longitude of perihelion
+// follow their polynomials in Julian centuries, and the longitude is corrected
+// for aberration and nutation, which keeps the declination within about 0.05°
+// and the transit within about 20 seconds over ±3000 years.
+func sunriseEquation(d float64, secular bool) sunriseEquationResult {
+	var r sunriseEquationResult
+
+	r.meanAnomaly = meanAnomaly(d)
+	if !secular {
+		r.equationOfCenter = equationOfCenter(r.meanAnomaly)
+		r.eclipticLongitude = eclipticLongitude(r.meanAnomaly, r.equationOfCenter, d)
+		r.obliquity = math.Asin(SinDeclinationCoefficient) / Degree
+		r.declination = declination(r.eclipticLongitude)
+		r.transit = transit(d, r.meanAnomaly, r.eclipticLongitude)
+		return r
+	}
+
+	var (
+		t = julianCentury(d)
+		e = eccentricity(t)
+		// Longitude of the ascending node of the moon, for the nutation
+		omega = (125.04 - 1934.136*t) * Degree
+	)
+	r.equationOfCenter = equationOfCenterSecular(r.meanAnomaly, e)
+	// Apparent longitude, corrected for aberration and nutation
+	r.eclipticLongitude = limitDegrees(r.meanAnomaly + r.equationOfCenter + HalfCircleDegrees +
+		longitudeOfPerihelion(t) - 0.00569 - 0.00478*math.Sin(omega))
+	r.obliquity = meanObliquity(t)
+	r.declination = declinationAt(r.eclipticLongitude, r.obliquity)
+	r.transit = transitSecular(d, r.meanAnomaly, r.eclipticLongitude, e, r.obliquity)
+	return r
+}
+
+// julianCentury returns the number of Julian centuries between J2000 and the
+// Julian day d.
+func julianCentury(d float64) float64 {
+	return (d - J2000) / JulianCenturyDays
+}
@@ -0,0 +1,103 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestSunriseEquation_Fixed checks that the fast path is the constant-based
+// chain of the sunrise equation.
+func TestSunriseEquation_Fixed(t *testing.T) {
+	d := 2451545.2205
+	r := sunriseEquation(d, false)
+
+	var (
+		m = meanAnomaly(d)
+		l = eclipticLongitude(m, equationOfCenter(m), d)
+	)
+	if r.meanAnomaly != m || r.eclipticLongitude != l {
+		t.Errorf("anomaly, longitude = %f, %f, want %f, %f", r.meanAnomaly, r.eclipticLongitude, m, l)
+	}
+	if r.declination != declination(l) || r.transit != transit(d, m, l) {
+		t.Errorf("declination, transit = %f, %f, want %f, %f", r.declination, r.transit, declination(l), transit(d, m, l))
+	}
+}
+
+// TestSunriseEquation_Secular checks the declination and equation of time
+// against the SPA over ±3000 years, where the constants drift by degrees.
+func TestSunriseEquation_Secular(t *testing.T) {
+	for _, year := range []int{-1000, 0, 1000, 1600, 2000, 2500, 3000} {
+		for _, month := range []time.Month{time.March, time.June, time.September, time.December} {
+			var (
+				jd  = TimeToJulianDay(time.Date(year, month, 1, 12, 0, 0, 0, time.UTC))
+				ref = spaPosition(jd, 0, 0, 0, 0)
+				r   = sunriseEquation(jd, true)
+				eot = (jd - r.transit) * 1440
+			)
+			if d := math.Abs(r.declination - ref.declination); d > 0.06 {
+				t.Errorf("%d-%02d: declination = %.4f, SPA = %.4f", year, month, r.declination, ref.declination)
+			}
+			if d := math.Abs(eot - ref.equationOfTime); d > 0.35 {
+				t.Errorf("%d-%02d: equation of time = %.2f, SPA = %.2f", year, month, eot, ref.equationOfTime)
+			}
+		}
+	}
+
+	// The constants are already off by degrees in 1000 BC
+	jd := TimeToJulianDay(time.Date(-1000, time.March, 1, 12, 0, 0, 0, time.UTC))
+	if d := math.Abs(sunriseEquation(jd, false).declination - spaPosition(jd, 0, 0, 0, 0).declination); d < 1 {
+		t.Errorf("constant declination error = %.4f, want more than 1°", d)
+	}
+}
+
+func TestSecularTerms(t *testing.T) {
+	loc := NewLocation(41.9, 12.5)
+	if loc.SecularTerms() {
+		t.Error("SecularTerms() = true by default")
+	}
+	secular := loc.WithSecularTerms(true)
+	if !secular.SecularTerms() {
+		t.Error("WithSecularTerms(true).SecularTerms() = false")
+	}
+
+	// In 1600 the secular terms bring sunrise and sunset within a minute of
+	// the SPA, while the constants are several minutes off
+	tm := NewTime(1600, time.March, 1)
+	refSunrise, refSunset, err := SunriseSunset(loc, tm, SPA)
+	if err != nil {
+		t.Fatalf("SunriseSunset(SPA) error = %v", err)
+	}
+	sunrise, sunset, err := SunriseSunset(secular, tm)
+	if err != nil {
+		t.Fatalf("SunriseSunset(secular) error = %v", err)
+	}
+	for name, d := range map[string]time.Duration{"sunrise": sunrise.Sub(refSunrise), "sunset": sunset.Sub(refSunset)} {
+		if d.Abs() > time.Minute {
+			t.Errorf("secular %s is %s from the SPA", name, d)
+		}
+	}
+	fixed, _, _ := SunriseSunset(loc, tm)
+	if d := fixed.Sub(refSunrise); d.Abs() < 5*time.Minute {
+		t.Errorf("constant sunrise is only %s from the SPA", d)
+	}
+
+	// The other sunrise equation entry points follow the option too
+	when := time.Date(1600, time.March, 1, 12, 0, 0, 0, time.UTC)
+	if Position(secular, when).Declination == Position(loc, when).Declination {
+		t.Error("Position ignores the secular terms")
+	}
+	morning, _ := TimeOfElevation(secular, 10, tm)
+	if fixedMorning, _ := TimeOfElevation(loc, 10, tm); morning.Equal(fixedMorning) {
+		t.Error("TimeOfElevation ignores the secular terms")
+	}
+}
+
+// BenchmarkSunriseEquation_Secular benchmarks the sunrise equation with
+// secular orbital elements
+func BenchmarkSunriseEquation_Secular(b *testing.B) {
+	b.ResetTimer()
+	for b.Loop() {
+		_ = sunriseEquation(2451545.0, true)
+	}
+}
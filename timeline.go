@@ -0,0 +1,322 @@
+package solar
+
+import (
+	"sort"
+	"time"
+)
+
+// EventKind identifies a solar event of the day.
+type EventKind int
+
+const (
+	// EventAstronomicalDawn is when the rising sun reaches 18° below the horizon.
+	EventAstronomicalDawn EventKind = iota
+
+	// EventNauticalDawn is when the rising sun reaches 12° below the horizon.
+	EventNauticalDawn
+
+	// EventCivilDawn is when the rising sun reaches 6° below the horizon.
+	EventCivilDawn
+
+	// EventSunrise is when the sun's upper limb rises above the horizon.
+	EventSunrise
+
+	// EventGoldenHourEnd is when the rising sun reaches 6° above the horizon,
+	// the upper elevation of the GoldenHour twilight type, ending the morning
+	// golden hour.
+	EventGoldenHourEnd
+
+	// EventSolarNoon is when the sun crosses the meridian at its highest.
+	EventSolarNoon
+
+	// EventGoldenHourStart is when the setting sun reaches 6° above the horizon,
+	// the upper elevation of the GoldenHour twilight type, starting the evening
+	// golden hour.
+	EventGoldenHourStart
+
+	// EventSunset is when the sun's upper limb sets below the horizon.
+	EventSunset
+
+	// EventCivilDusk is when the setting sun reaches 6° below the horizon.
+	EventCivilDusk
+
+	// EventNauticalDusk is when the setting sun reaches 12° below the horizon.
+	EventNauticalDusk
+
+	// EventAstronomicalDusk is when the setting sun reaches 18° below the horizon.
+	EventAstronomicalDusk
+
+	// EventSolarMidnight is when the sun crosses the meridian at its lowest,
+	// at the start of the solar day.
+	EventSolarMidnight
+)
+
+// eventKindNames holds the name of every event kind, indexed by the kind.
+var eventKindNames = []string{
+	EventAstronomicalDawn: "AstronomicalDawn",
+	EventNauticalDawn:     "NauticalDawn",
+	EventCivilDawn:        "CivilDawn",
+	EventSunrise:          "Sunrise",
+	EventGoldenHourEnd:    "GoldenHourEnd",
+	EventSolarNoon:        "SolarNoon",
+	EventGoldenHourStart:  "GoldenHourStart",
+	EventSunset:           "Sunset",
+	EventCivilDusk:        "CivilDusk",
+	EventNauticalDusk:     "NauticalDusk",
+	EventAstronomicalDusk: "AstronomicalDusk",
+	EventSolarMidnight:    "SolarMidnight",
+}
+
+// String returns the name of the event kind.
+func (k EventKind) String() string {
+	if k < 0 || int(k) >= len(eventKindNames) {
+		return "Unknown"
+	}
+	return eventKindNames[k]
+}
+
+// Event is a solar event of a day's timeline.
+type Event struct {
+	// Kind identifies the event.
+	Kind EventKind
+
+	// Time is the instant of the event, or the zero time if it does not occur.
+	Time time.Time
+
+	// Position is the position of the sun at the instant of the event, or the
+	// zero SunPosition if it does not occur.
+	Position SunPosition
+
+	// Err tells why the event does not occur: an *ElevationError if the sun
+	// stays above or below the event's elevation all day, or ErrNoEventOnDay
+	// if it falls outside the local civil day. It is nil if the event occurs.
+	Err error
+}
+
+// Occurs reports whether the event occurs on the day.
+func (e Event) Occurs() bool {
+	return e.Err == nil
+}
+
+// Timeline calculates every solar event of a day at the specified location:
+// astronomical, nautical and civil dawn, sunrise, the end of the morning golden
+// hour, solar noon, the start of the evening golden hour, sunset, civil,
+// nautical and astronomical dusk, and solar midnight, each with the sun's
+// position at that instant.
+//
+// The sun's declination and equation of time are computed once per date and
+// shared by every event, instead of once or twice per event as separate calls
+// to Sunrise, DawnDusk and SolarNoon do. With the sunrise equation the rise,
+// set and twilight times are those of the separate calls; with NOAA and SPA
+// the ephemeris is interpolated between three evaluations over the day and the
+// times agree with the separate calls to within a second. Solar noon and
+// midnight are computed as by SolarNoon and SolarMidnight. The location's
+// refinement option is honored.
+//
+// Every kind of event is returned, so a day always has twelve events. The
+// events that occur come first, in chronological order. The events that do
+// not occur, such as sunrise during the polar night, follow in the order of
+// their kinds, with a zero time and an Err explaining why.
+//
+// Parameters:
+//   - loc: Location created via NewLocation(), NewObserver() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Optional algorithm (SunriseEquation, NOAA or SPA). Defaults to the location's algorithm.
+//
+// Returns:
+//   - The day's events, in UTC or in the time zone of t
+//
+// Example:
+//
+//	loc := solar.NewLocation(43.65, -79.38)
+//	for _, e := range solar.Timeline(loc, solar.NewTime(2024, time.June, 21)) {
+//	    if e.Occurs() {
+//	        fmt.Printf("%-16s %s %5.1f°\n", e.Kind, e.Time.Format("15:04"), e.Position.Elevation)
+//	    }
+//	}
+func Timeline(loc Location, t Time, algorithm ...Algorithm) []Event {
+	var (
+		alg         = selectAlgorithm(loc, algorithm)
+		ephemerides []*dayEphemeris
+	)
+
+	// A zoned day draws its events from several UTC dates; compute each
+	// date's ephemeris only once
+	ephemeris := func(year int, month time.Month, day int) *dayEphemeris {
+		for _, e := range ephemerides {
+			if e.year == year && e.month == month && e.day == day {
+				return e
+			}
+		}
+		e := newDayEphemeris(alg, loc, year, month, day)
+		ephemerides = append(ephemerides, e)
+		return e
+	}
+
+	events := make([]Event, len(eventKindNames))
+	for i := range events {
+		kind := EventKind(i)
+		when, err := onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+			return ephemeris(year, month, day).event(kind)
+		})
+
+		events[i] = Event{Kind: kind, Err: err}
+		if err == nil {
+			events[i].Time = when
+			events[i].Position = Position(loc.WithTimeScale(UTC), when, alg)
+		}
+	}
+
+	sortOccurring(events)
+	return events
+}
+
+// sortOccurring sorts the events that occur chronologically, followed by the
+// events that do not occur in the order of their kinds.
+func sortOccurring(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i], events[j]
+		if a.Occurs() != b.Occurs() {
+			return a.Occurs()
+		}
+		if !a.Occurs() {
+			return a.Kind < b.Kind
+		}
+		return a.Time.Before(b.Time)
+	})
+}
+
+// dayEphemeris holds the sun's motion over a UTC date, computed once and
+// shared by every event of the date.
+type dayEphemeris struct {
+	algorithm Algorithm
+	loc       Location
+	year      int
+	month     time.Month
+	day       int
+	jd0       float64 // 0h UTC on the date
+
+	// sun is the sunrise equation at mean solar noon, for SunriseEquation
+	sun sunriseEquationResult
+
+	// declination (degrees) and equationOfTime (minutes) at 0h, 12h and 24h
+	// UTC, for NOAA and SPA
+	declination    [3]float64
+	equationOfTime [3]float64
+}
+
+// newDayEphemeris computes the ephemeris of the UTC date with the given
+// algorithm.
+func newDayEphemeris(algorithm Algorithm, loc Location, year int, month time.Month, day int) *dayEphemeris {
+	e := &dayEphemeris{
+		algorithm: algorithm,
+		loc:       loc,
+		year:      year,
+		month:     month,
+		day:       day,
+		jd0:       TimeToJulianDay(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)),
+	}
+
+	if algorithm == SunriseEquation {
+		e.sun = sunriseEquation(meanSolarNoonInternal(loc.Longitude(), year, month, day), loc.SecularTerms())
+		return e
+	}
+	for i := range 3 {
+		e.declination[i], e.equationOfTime[i] = apparentSun(algorithm, e.jd0+float64(i)/2, false)
+	}
+	return e
+}
+
+// sunAt returns the declination (degrees) and equation of time (minutes) at
+// the Julian day jd, interpolated between the samples of the date.
+func (e *dayEphemeris) sunAt(jd float64) (declination, equationOfTime float64) {
+	n := (jd-e.jd0)*2 - 1 // -1 at 0h, 0 at 12h and 1 at 24h
+	return interpolate3(e.declination, n), interpolate3(e.equationOfTime, n)
+}
+
+// interpolate3 interpolates between three equally spaced values at the
+// interpolating factor n, from -1 at the first to 1 at the last (Meeus,
+// Astronomical Algorithms, formula 3.3).
+func interpolate3(y [3]float64, n float64) float64 {
+	var (
+		a = y[1] - y[0]
+		b = y[2] - y[1]
+		c = b - a
+	)
+	return y[1] + n/2*(a+b+n*c)
+}
+
+// event returns the instant of the event of the given kind on the date.
+func (e *dayEphemeris) event(kind EventKind) (time.Time, error) {
+	switch kind {
+	case EventAstronomicalDawn:
+		return e.elevationTime(AstronomicalTwilightAngle, true)
+	case EventNauticalDawn:
+		return e.elevationTime(NauticalTwilightAngle, true)
+	case EventCivilDawn:
+		return e.elevationTime(CivilTwilightAngle, true)
+	case EventSunrise:
+		return e.elevationTime(e.horizon(), true)
+	case EventGoldenHourEnd:
+		upper, _ := GoldenHour.Elevations()
+		return e.elevationTime(upper, true)
+	case EventSolarNoon:
+		return e.culmination(12), nil
+	case EventGoldenHourStart:
+		upper, _ := GoldenHour.Elevations()
+		return e.elevationTime(upper, false)
+	case EventSunset:
+		return e.elevationTime(e.horizon(), false)
+	case EventCivilDusk:
+		return e.elevationTime(CivilTwilightAngle, false)
+	case EventNauticalDusk:
+		return e.elevationTime(NauticalTwilightAngle, false)
+	case EventAstronomicalDusk:
+		return e.elevationTime(AstronomicalTwilightAngle, false)
+	default:
+		return e.culmination(0), nil
+	}
+}
+
+// horizon returns the elevation, in degrees, of the sun's center at sunrise
+// and sunset on the date.
+func (e *dayEphemeris) horizon() float64 {
+	return e.loc.horizonOn(e.algorithm, e.year, e.month, e.day) / Degree
+}
+
+// elevationTime returns the time at which the sun reaches the elevation in the
+// morning (rising) or the evening on the date, refined if the location asks
+// for it, or an *ElevationError if it never does.
+func (e *dayEphemeris) elevationTime(elevation float64, rising bool) (time.Time, error) {
+	var (
+		when time.Time
+		err  error
+	)
+	if e.algorithm == SunriseEquation {
+		var morning, evening time.Time
+		morning, evening, err = sunriseEquationElevationTimes(e.loc.Latitude(), elevation, e.sun)
+		when = evening
+		if rising {
+			when = morning
+		}
+	} else {
+		var jd float64
+		jd, err = eventJulianDay(e.sunAt, e.loc.Latitude(), e.loc.Longitude(), elevation, e.jd0, rising)
+		when = JulianDayToTime(jd)
+	}
+	if err != nil {
+		return time.Time{}, elevationError(elevation, err)
+	}
+
+	if e.loc.Refinement() {
+		when = refineEvent(e.algorithm, e.loc, elevation, when)
+	}
+	return when, nil
+}
+
+// culmination returns the instant of the given local apparent solar time hour
+// on the date: 12 for solar noon and 0 for solar midnight. It is computed as
+// by SolarNoon and SolarMidnight, from the equation of time at the instant.
+func (e *dayEphemeris) culmination(hour int) time.Time {
+	return culminationTime(e.loc.WithAlgorithm(e.algorithm), hour, e.year, e.month, e.day)
+}
@@ -0,0 +1,238 @@
+package solar
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestTimeline checks every event of an ordinary day against the separate
+// event functions
+func TestTimeline(t *testing.T) {
+	tests := []struct {
+		algorithm Algorithm
+		tolerance time.Duration
+	}{
+		{SunriseEquation, time.Millisecond},
+		{NOAA, time.Second},
+		{SPA, time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm.String(), func(t *testing.T) {
+			loc := NewLocation(43.65, -79.38).WithAlgorithm(tt.algorithm)
+			tm := NewTime(2024, time.June, 21)
+
+			sunrise, sunset, err := SunriseSunset(loc, tm)
+			if err != nil {
+				t.Fatalf("SunriseSunset() error = %v", err)
+			}
+			_, goldenEnd, err := MorningTwilight(loc, tm, GoldenHour)
+			if err != nil {
+				t.Fatalf("MorningTwilight() error = %v", err)
+			}
+			goldenStart, _, err := EveningTwilight(loc, tm, GoldenHour)
+			if err != nil {
+				t.Fatalf("EveningTwilight() error = %v", err)
+			}
+			want := map[EventKind]time.Time{
+				EventAstronomicalDawn: Dawn(loc, tm, Astronomical),
+				EventNauticalDawn:     Dawn(loc, tm, Nautical),
+				EventCivilDawn:        Dawn(loc, tm, Civil),
+				EventSunrise:          sunrise,
+				EventGoldenHourEnd:    goldenEnd,
+				EventSolarNoon:        SolarNoon(loc, tm).Time,
+				EventGoldenHourStart:  goldenStart,
+				EventSunset:           sunset,
+				EventCivilDusk:        Dusk(loc, tm, Civil),
+				EventNauticalDusk:     Dusk(loc, tm, Nautical),
+				EventAstronomicalDusk: Dusk(loc, tm, Astronomical),
+				EventSolarMidnight:    SolarMidnight(loc, tm).Time,
+			}
+
+			events := Timeline(loc, tm)
+			if len(events) != len(want) {
+				t.Fatalf("Timeline() = %d events, want %d", len(events), len(want))
+			}
+			for i, e := range events {
+				if !e.Occurs() {
+					t.Errorf("%s error = %v", e.Kind, e.Err)
+					continue
+				}
+				if d := e.Time.Sub(want[e.Kind]); d < -tt.tolerance || d > tt.tolerance {
+					t.Errorf("%s = %s, want %s", e.Kind, e.Time, want[e.Kind])
+				}
+				if pos := Position(loc, e.Time); !AlmostEqual(e.Position.Elevation, pos.Elevation, 1e-9) {
+					t.Errorf("%s elevation = %f, want %f", e.Kind, e.Position.Elevation, pos.Elevation)
+				}
+				if i > 0 && e.Time.Before(events[i-1].Time) {
+					t.Errorf("%s at %s is before %s at %s", e.Kind, e.Time, events[i-1].Kind, events[i-1].Time)
+				}
+			}
+
+			// Solar midnight starts the solar day, before dawn
+			if events[0].Kind != EventSolarMidnight || events[len(events)-1].Kind != EventAstronomicalDusk {
+				t.Errorf("Timeline() runs from %s to %s, want SolarMidnight to AstronomicalDusk", events[0].Kind, events[len(events)-1].Kind)
+			}
+		})
+	}
+}
+
+// TestTimeline_Missing checks that events that do not occur are returned after
+// those that do, with the reason
+func TestTimeline_Missing(t *testing.T) {
+	// Tromsø at midwinter: civil twilight around noon, but no sunrise
+	loc := NewLocation(69.65, 18.96)
+	events := Timeline(loc, NewTime(2024, time.December, 21))
+
+	missing := map[EventKind]bool{
+		EventSunrise:         true,
+		EventGoldenHourEnd:   true,
+		EventGoldenHourStart: true,
+		EventSunset:          true,
+	}
+	checkTimelineOrder(t, events)
+	for _, e := range events {
+		if e.Occurs() == missing[e.Kind] {
+			t.Errorf("%s occurs = %t, want %t", e.Kind, e.Occurs(), !missing[e.Kind])
+		}
+		if !missing[e.Kind] {
+			continue
+		}
+		if !e.Time.IsZero() || e.Position != (SunPosition{}) {
+			t.Errorf("missing %s has time %s", e.Kind, e.Time)
+		}
+		var elevErr *ElevationError
+		if !errors.As(e.Err, &elevErr) || elevErr.Above || !errors.Is(e.Err, ErrSunNeverRises) {
+			t.Errorf("%s error = %v, want the sun always below", e.Kind, e.Err)
+		}
+	}
+
+	// Longyearbyen at midsummer: the sun never sets
+	events = Timeline(NewLocation(78.22, 15.65), NewTime(2024, time.June, 21))
+	for _, e := range events {
+		if e.Kind == EventSolarNoon || e.Kind == EventSolarMidnight {
+			if !e.Occurs() {
+				t.Errorf("%s error = %v", e.Kind, e.Err)
+			}
+			continue
+		}
+		if !errors.Is(e.Err, ErrSunNeverSets) {
+			t.Errorf("%s error = %v, want %v", e.Kind, e.Err, ErrSunNeverSets)
+		}
+	}
+}
+
+// TestTimeline_Order checks the order of a day at a high latitude, where the
+// golden hour starts after the sun has stopped setting below the twilight
+// elevations
+func TestTimeline_Order(t *testing.T) {
+	loc := NewLocation(69.65, 18.96) // Tromsø
+	events := Timeline(loc, NewTimeIn(2024, time.May, 21, time.FixedZone("CEST", 2*60*60)))
+	checkTimelineOrder(t, events)
+
+	want := []EventKind{
+		EventSolarMidnight, EventGoldenHourEnd, EventSolarNoon, EventGoldenHourStart,
+		EventAstronomicalDawn, EventNauticalDawn, EventCivilDawn, EventSunrise,
+		EventSunset, EventCivilDusk, EventNauticalDusk, EventAstronomicalDusk,
+	}
+	for i, e := range events {
+		if e.Kind != want[i] {
+			t.Errorf("event %d = %s, want %s", i, e.Kind, want[i])
+		}
+	}
+}
+
+// checkTimelineOrder checks that the events that occur come first in
+// chronological order, followed by the others in the order of their kinds
+func checkTimelineOrder(t *testing.T, events []Event) {
+	t.Helper()
+	for i := 1; i < len(events); i++ {
+		prev, e := events[i-1], events[i]
+		switch {
+		case e.Occurs() && !prev.Occurs():
+			t.Errorf("%s occurs after %s, which does not", e.Kind, prev.Kind)
+		case e.Occurs() && e.Time.Before(prev.Time):
+			t.Errorf("%s at %s is before %s at %s", e.Kind, e.Time, prev.Kind, prev.Time)
+		case !e.Occurs() && !prev.Occurs() && e.Kind < prev.Kind:
+			t.Errorf("missing %s is after missing %s", e.Kind, prev.Kind)
+		}
+	}
+}
+
+func TestTimeline_Zone(t *testing.T) {
+	zone := time.FixedZone("NZST", 12*60*60)
+	loc := NewLocation(-36.85, 174.76) // Auckland
+	tm := NewTimeIn(2024, time.June, 21, zone)
+	start := tm.DateTime()
+
+	sunrise, sunset, err := SunriseSunset(loc, tm)
+	if err != nil {
+		t.Fatalf("SunriseSunset() error = %v", err)
+	}
+	for _, e := range Timeline(loc, tm) {
+		if !e.Occurs() {
+			t.Errorf("%s error = %v", e.Kind, e.Err)
+			continue
+		}
+		if e.Time.Location() != zone || e.Time.Before(start) || !e.Time.Before(start.AddDate(0, 0, 1)) {
+			t.Errorf("%s = %s, want within the local day", e.Kind, e.Time)
+		}
+		switch e.Kind {
+		case EventSunrise:
+			if !e.Time.Equal(sunrise) {
+				t.Errorf("sunrise = %s, want %s", e.Time, sunrise)
+			}
+		case EventSunset:
+			if !e.Time.Equal(sunset) {
+				t.Errorf("sunset = %s, want %s", e.Time, sunset)
+			}
+		}
+	}
+}
+
+func TestTimeline_Refinement(t *testing.T) {
+	loc := NewLocation(69.65, 18.96).WithRefinement(true)
+	tm := NewTime(2024, time.March, 20)
+
+	want, err := Sunrise(loc, tm)
+	if err != nil {
+		t.Fatalf("Sunrise() error = %v", err)
+	}
+	for _, e := range Timeline(loc, tm) {
+		if e.Kind == EventSunrise && !e.Time.Equal(want) {
+			t.Errorf("refined sunrise = %s, want %s", e.Time, want)
+		}
+	}
+}
+
+func TestInterpolate3(t *testing.T) {
+	// A quadratic is interpolated exactly
+	f := func(x float64) float64 { return 3*x*x - 2*x + 5 }
+	y := [3]float64{f(-1), f(0), f(1)}
+	for _, n := range []float64{-1, -0.5, 0, 0.25, 1, 1.5} {
+		if got := interpolate3(y, n); !AlmostEqual(got, f(n), 1e-12) {
+			t.Errorf("interpolate3(%g) = %f, want %f", n, got, f(n))
+		}
+	}
+}
+
+func TestEventKind_String(t *testing.T) {
+	if EventSunrise.String() != "Sunrise" || EventSolarMidnight.String() != "SolarMidnight" {
+		t.Errorf("String() = %q, %q", EventSunrise, EventSolarMidnight)
+	}
+	if got := EventKind(99).String(); got != "Unknown" {
+		t.Errorf("EventKind(99).String() = %q, want Unknown", got)
+	}
+}
+
+// BenchmarkTimeline benchmarks computing every event of a day
+func BenchmarkTimeline(b *testing.B) {
+	loc := NewLocation(40.7128, -74.0060)
+	tm := NewTime(2024, time.June, 21)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = Timeline(loc, tm)
+	}
+}
@@ -0,0 +1,80 @@
+package solar
+
+import "time"
+
+// TimeScale identifies the time scale in which an instant or a Julian day is
+// expressed.
+//
+// Clocks keep UTC. The rotation of the Earth, and so the hour angle of the
+// sun, follows UT1, while the orbital theories behind the ephemerides use the
+// uniform Terrestrial Time (TT). The two drift apart by ΔT (see DeltaT), which
+// is about a minute today but several minutes in 1600 or 2200.
+//
+// UTC is kept within 0.9 seconds of UT1 by leap seconds, and the package does
+// not model that difference: UTC and UT1 are treated alike, and before 1972,
+// when UTC did not exist, a UTC time is read as UT1. Consequently the offset
+// between TT and UTC is ΔT rather than 32.184 seconds plus the leap seconds;
+// the two agree within a few seconds in the modern era.
+type TimeScale int
+
+const (
+	// UTC is Coordinated Universal Time, as kept by clocks and by time.Time.
+	// This is the default.
+	UTC TimeScale = iota
+
+	// UT1 is Universal Time, which follows the rotation of the Earth.
+	UT1
+
+	// TT is Terrestrial Time, the uniform time scale of the ephemerides. A
+	// Julian day in TT is a Julian Ephemeris Day (JDE).
+	TT
+)
+
+// String returns the name of the time scale.
+func (s TimeScale) String() string {
+	switch s {
+	case UT1:
+		return "UT1"
+	case TT:
+		return "TT"
+	default:
+		return "UTC"
+	}
+}
+
+// selectTimeScale returns the first time scale in the optional list, or UTC
+// when none is given.
+func selectTimeScale(scale []TimeScale) TimeScale {
+	if len(scale) > 0 {
+		return scale[0]
+	}
+	return UTC
+}
+
+// ConvertTimeScale converts an instant from one time scale into another. The
+// result has the time zone of when; only the clock reading changes.
+//
+// Parameters:
+//   - when: The instant, read in the scale from
+//   - from: Time scale of when
+//   - to: Time scale of the result
+//
+// Returns:
+//   - The same instant read in the scale to
+//
+// Example:
+//
+//	utc := time.Date(1600, time.June, 21, 12, 0, 0, 0, time.UTC)
+//	tt := solar.ConvertTimeScale(utc, solar.UTC, solar.TT)
+//	// tt is about two minutes after utc
+func ConvertTimeScale(when time.Time, from, to TimeScale) time.Time {
+	if (from == TT) == (to == TT) {
+		return when
+	}
+	if to == TT {
+		return when.Add(DeltaT(when))
+	}
+
+	// ΔT is evaluated at the universal time, which is estimated first
+	return when.Add(-DeltaT(when.Add(-DeltaT(when))))
+}
@@ -0,0 +1,91 @@
+package solar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeScale_String(t *testing.T) {
+	for scale, want := range map[TimeScale]string{UTC: "UTC", UT1: "UT1", TT: "TT"} {
+		if got := scale.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestConvertTimeScale(t *testing.T) {
+	for _, year := range []int{-500, 1600, 1900, 2024, 2200} {
+		utc := time.Date(year, time.June, 21, 12, 0, 0, 0, time.UTC)
+		dt := DeltaT(utc)
+
+		if got := ConvertTimeScale(utc, UTC, UT1); !got.Equal(utc) {
+			t.Errorf("%d: UTC to UT1 = %s, want %s", year, got, utc)
+		}
+		tt := ConvertTimeScale(utc, UTC, TT)
+		if got := tt.Sub(utc); got != dt {
+			t.Errorf("%d: TT - UTC = %s, want %s", year, got, dt)
+		}
+		if got := ConvertTimeScale(tt, TT, UTC); got.Sub(utc).Abs() > time.Microsecond {
+			t.Errorf("%d: round trip = %s, want %s", year, got, utc)
+		}
+		if got := ConvertTimeScale(tt, TT, UT1); !got.Equal(ConvertTimeScale(tt, TT, UTC)) {
+			t.Errorf("%d: TT to UT1 = %s, want the UTC reading", year, got)
+		}
+		if got := ConvertTimeScale(tt, TT, TT); !got.Equal(tt) {
+			t.Errorf("%d: TT to TT = %s, want %s", year, got, tt)
+		}
+	}
+}
+
+func TestTimeToJulianDay_Scale(t *testing.T) {
+	when := time.Date(1600, time.June, 21, 12, 0, 0, 0, time.UTC)
+	jd := TimeToJulianDay(when)
+
+	if got := TimeToJulianDay(when, UTC); got != jd {
+		t.Errorf("TimeToJulianDay(UTC) = %f, want %f", got, jd)
+	}
+	if got := TimeToJulianDay(when, UT1); got != jd {
+		t.Errorf("TimeToJulianDay(UT1) = %f, want %f", got, jd)
+	}
+	jde := TimeToJulianDay(when, TT)
+	if want := TimeToJulianDay(ConvertTimeScale(when, UTC, TT)); !AlmostEqual(jde, want, 1e-8) {
+		t.Errorf("TimeToJulianDay(TT) = %f, want %f", jde, want)
+	}
+	if got := JulianDayToTime(jde, TT); got.Sub(when).Abs() > 100*time.Microsecond {
+		t.Errorf("JulianDayToTime(TT) = %s, want %s", got, when)
+	}
+	if got := JulianDayToTime(jd, UT1); got.Sub(when).Abs() > 100*time.Microsecond {
+		t.Errorf("JulianDayToTime(UT1) = %s, want %s", got, when)
+	}
+}
+
+func TestPosition_TimeScale(t *testing.T) {
+	loc := NewLocation(51.48, 0)
+	if loc.TimeScale() != UTC {
+		t.Errorf("TimeScale() = %s, want UTC by default", loc.TimeScale())
+	}
+
+	// An instant read in TT is ΔT earlier in universal time
+	when := time.Date(1600, time.June, 21, 12, 0, 0, 0, time.UTC)
+	ut := ConvertTimeScale(when, TT, UTC)
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		got := Position(loc.WithTimeScale(TT), when, algorithm)
+		want := Position(loc, ut, algorithm)
+		if got != want {
+			t.Errorf("%s: Position(TT) = %+v, want %+v", algorithm, got, want)
+		}
+		if Position(loc, when, algorithm) == want {
+			t.Errorf("%s: Position ignores the time scale", algorithm)
+		}
+	}
+}
+
+// BenchmarkConvertTimeScale benchmarks the conversion from TT to UTC
+func BenchmarkConvertTimeScale(b *testing.B) {
+	when := time.Date(1600, time.June, 21, 12, 0, 0, 0, time.UTC)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = ConvertTimeScale(when, TT, UTC)
+	}
+}
@@ -0,0 +1,93 @@
+package solar
+
+import "math"
+
+const (
+	// wgs84SemiMajorAxis is the equatorial radius of the WGS84 ellipsoid in
+	// meters.
+	wgs84SemiMajorAxis = 6378137.0
+
+	// wgs84Flattening is the flattening of the WGS84 ellipsoid.
+	wgs84Flattening = 1 / 298.257223563
+
+	// solarParallax is the equatorial horizontal parallax of the sun at one
+	// astronomical unit (8.794143 arc seconds, in degrees).
+	solarParallax = 8.794143 / 3600
+)
+
+// Topocentric describes the sun as seen from the observer's position on the
+// surface of the Earth rather than from its center. All angles are in
+// degrees.
+//
+// Seen from the surface, the sun is displaced by the solar parallax, at most
+// 8.8 arc seconds, away from the observer's zenith. The displacement depends
+// on the observer's distance from the Earth's center, which is computed from
+// the geodetic latitude on the WGS84 ellipsoid and the height above it.
+type Topocentric struct {
+	// Elevation is the true angle of the sun's center above the horizon,
+	// without atmospheric refraction.
+	Elevation float64
+
+	// Azimuth is the compass direction of the sun, measured clockwise from
+	// true north.
+	Azimuth float64
+
+	// HourAngle is the angular distance of the sun from the local meridian,
+	// negative in the morning and positive in the afternoon (-180° to 180°).
+	HourAngle float64
+
+	// Declination is the angle of the sun north (positive) or south
+	// (negative) of the celestial equator.
+	Declination float64
+
+	// RightAscension is the angle of the sun measured eastward along the
+	// celestial equator from the vernal equinox (0° to 360°).
+	RightAscension float64
+
+	// Parallax is the horizontal parallax of the sun at its current distance:
+	// the largest displacement, reached when the sun is on the horizon.
+	Parallax float64
+}
+
+// geocentricObserver returns ρ·sin φ′ and ρ·cos φ′, the components of the
+// observer's distance from the Earth's center along the polar axis and in the
+// equatorial plane, in units of the equatorial radius, for a geodetic latitude
+// (degrees) and a height above the WGS84 ellipsoid (meters).
+func geocentricObserver(latitude, height float64) (rhoSin, rhoCos float64) {
+	var (
+		latRad = latitude * Degree
+		ratio  = 1 - wgs84Flattening // b/a
+		u      = math.Atan(ratio * math.Tan(latRad))
+		h      = height / wgs84SemiMajorAxis
+	)
+	rhoSin = ratio*math.Sin(u) + h*math.Sin(latRad)
+	rhoCos = math.Cos(u) + h*math.Cos(latRad)
+	return rhoSin, rhoCos
+}
+
+// topocentricPosition corrects the geocentric position of the sun for the
+// parallax seen by an observer at the given latitude (degrees) and height
+// (meters), following Meeus, "Astronomical Algorithms", chapter 40.
+func topocentricPosition(latitude, height float64, pos SunPosition) Topocentric {
+	var (
+		rhoSin, rhoCos = geocentricObserver(latitude, height)
+		parallax       = math.Asin(math.Sin(solarParallax*Degree) / pos.Distance)
+		sinParallax    = math.Sin(parallax)
+		hRad           = pos.HourAngle * Degree
+		declRad        = pos.Declination * Degree
+		denominator    = math.Cos(declRad) - rhoCos*sinParallax*math.Cos(hRad)
+		deltaAlpha     = math.Atan2(-rhoCos*sinParallax*math.Sin(hRad), denominator)
+		topoDecl       = math.Atan2((math.Sin(declRad)-rhoSin*sinParallax)*math.Cos(deltaAlpha), denominator) / Degree
+		topoHourAngle  = signedDegrees(pos.HourAngle - deltaAlpha/Degree)
+	)
+
+	elevation, azimuth := horizontalCoordinates(latitude, topoDecl, topoHourAngle)
+	return Topocentric{
+		Elevation:      elevation,
+		Azimuth:        azimuth,
+		HourAngle:      topoHourAngle,
+		Declination:    topoDecl,
+		RightAscension: limitDegrees(pos.RightAscension + deltaAlpha/Degree),
+		Parallax:       parallax / Degree,
+	}
+}
@@ -0,0 +1,113 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestTopocentricPosition_Reference checks the correction against the
+// topocentric values published in the SPA report, from its geocentric values.
+func TestTopocentricPosition_Reference(t *testing.T) {
+	ref := spaReference
+	r := spaPosition(TimeToJulianDay(ref.when), ref.deltaT, ref.latitude, ref.longitude, ref.height)
+	topo := topocentricPosition(ref.latitude, ref.height, r.sunPosition())
+
+	tests := []struct {
+		name      string
+		got       float64
+		want      float64
+		tolerance float64
+	}{
+		{"RightAscension", topo.RightAscension, 202.22704, 1e-5},
+		{"Declination", topo.Declination, -9.316179, 1e-5},
+		{"HourAngle", topo.HourAngle, 11.10627, 1e-5},
+		{"Elevation", topo.Elevation, 39.872046, 1e-5},
+		{"Azimuth", topo.Azimuth, 194.340241, 1e-5},
+		{"Parallax", topo.Parallax, 8.794143 / 3600 / r.radiusVector, 1e-9},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.got-tt.want) > tt.tolerance {
+			t.Errorf("%s = %.7f, want %.7f (±%g)", tt.name, tt.got, tt.want, tt.tolerance)
+		}
+	}
+}
+
+func TestGeocentricObserver(t *testing.T) {
+	tests := []struct {
+		name     string
+		latitude float64
+		height   float64
+		rhoSin   float64
+		rhoCos   float64
+	}{
+		{"equator", 0, 0, 0, 1},
+		{"pole", 90, 0, 1 - wgs84Flattening, 0},
+		// Meeus example 11.a: Palomar Observatory, 33°21'22" N, 1706 m
+		{"Palomar", 33.356111, 1706, 0.546861, 0.836339},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rhoSin, rhoCos := geocentricObserver(tt.latitude, tt.height)
+			if !AlmostEqual(rhoSin, tt.rhoSin, 1e-6) || !AlmostEqual(rhoCos, tt.rhoCos, 1e-6) {
+				t.Errorf("geocentricObserver() = %f, %f, want %f, %f", rhoSin, rhoCos, tt.rhoSin, tt.rhoCos)
+			}
+		})
+	}
+}
+
+// TestPosition_Topocentric checks that the parallax lowers the sun by the
+// horizontal parallax times the cosine of the elevation, for every algorithm.
+func TestPosition_Topocentric(t *testing.T) {
+	loc := NewObserver(39.742476, -105.1786, 1830.14)
+	when := spaReference.when
+
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			pos := Position(loc, when, algorithm)
+			topo := pos.Topocentric
+
+			geocentric, _ := horizontalCoordinates(loc.Latitude(), pos.Declination, pos.HourAngle)
+			drop := geocentric - topo.Elevation
+			if want := topo.Parallax * math.Cos(geocentric*Degree); !AlmostEqual(drop, want, 1e-5) {
+				t.Errorf("parallax lowers the sun by %.7f°, want %.7f°", drop, want)
+			}
+			if topo.Parallax < 0.0024 || topo.Parallax > 0.0025 {
+				t.Errorf("Parallax = %f, want about 8.8\"", topo.Parallax)
+			}
+		})
+	}
+
+	// The SPA elevation is already topocentric
+	pos := Position(loc, when, SPA)
+	if !AlmostEqual(pos.Topocentric.Elevation, pos.Elevation, 1e-6) {
+		t.Errorf("SPA topocentric elevation = %f, want %f", pos.Topocentric.Elevation, pos.Elevation)
+	}
+	if !AlmostEqual(pos.Topocentric.Azimuth, pos.Azimuth, 1e-6) {
+		t.Errorf("SPA topocentric azimuth = %f, want %f", pos.Topocentric.Azimuth, pos.Azimuth)
+	}
+}
+
+// TestPosition_TopocentricHeight checks that a higher observer, farther from
+// the Earth's center, sees a larger displacement in declination.
+func TestPosition_TopocentricHeight(t *testing.T) {
+	when := time.Date(2024, time.June, 21, 18, 0, 0, 0, time.UTC)
+	low := Position(NewObserver(45, -90, 0), when, NOAA)
+	high := Position(NewObserver(45, -90, 8000), when, NOAA)
+
+	lowShift := low.Declination - low.Topocentric.Declination
+	highShift := high.Declination - high.Topocentric.Declination
+	if lowShift <= 0 || highShift <= lowShift {
+		t.Errorf("declination shifts = %g, %g, want positive and growing with height", lowShift, highShift)
+	}
+}
+
+// BenchmarkPosition_Topocentric benchmarks the topocentric correction
+func BenchmarkPosition_Topocentric(b *testing.B) {
+	pos := Position(NewObserver(39.742476, -105.1786, 1830.14), spaReference.when, NOAA)
+
+	b.ResetTimer()
+	for b.Loop() {
+		_ = topocentricPosition(39.742476, 1830.14, pos)
+	}
+}
@@ -10,3 +10,12 @@ func transit(d, meanAnomaly, eclipticLongitude float64) float64 {
 		EquationOfTimeC2*math.Sin(2*eclipticLongitude*Degree)
 	return d + equationOfTime
 }
+
+// transitSecular calculates the Julian date for the local true solar transit
+// from the eccentricity e and obliquity (degrees) of the date, whose
+// constant-based values give the coefficients used by transit.
+func transitSecular(d, meanAnomaly, eclipticLongitude, e, obliquity float64) float64 {
+	y := math.Pow(math.Tan(obliquity*Degree/2), 2)
+	equationOfTime := (2*e*math.Sin(meanAnomaly*Degree) - y*math.Sin(2*eclipticLongitude*Degree)) / (2 * math.Pi)
+	return d + equationOfTime
+}
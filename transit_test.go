@@ -36,3 +36,15 @@ func BenchmarkTransit(b *testing.B) {
 		_ = transit(d, meanAnomaly, eclipticLongitude)
 	}
 }
+
+// TestTransitSecular checks that the coefficients derived from the
+// eccentricity and obliquity at J2000 match the constants of the fast path.
+func TestTransitSecular(t *testing.T) {
+	obliquity := meanObliquity(0)
+	for _, tt := range dataTransit {
+		v := transitSecular(tt.inSolarNoon, tt.inSolarAnomaly, tt.inEclipticLongitude, eccentricity(0), obliquity)
+		if !AlmostEqual(v, tt.out, 0.00005) {
+			t.Errorf("transitSecular(%f) = %f, want %f", tt.inSolarNoon, v, tt.out)
+		}
+	}
+}
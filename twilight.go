@@ -1,10 +1,19 @@
 package solar
 
-import "time"
+import (
+	"fmt"
+	"math"
+	"time"
+)
 
 // TwilightType represents the type of twilight for dawn/dusk calculations.
 // Twilight is the period between daylight and darkness (or vice versa) when
 // the sun is below the horizon but its light is still visible.
+//
+// Each twilight type is a phase between an upper and a lower solar elevation.
+// Dawn is the morning instant at which the sun rises through the lower
+// elevation, and dusk the evening instant at which it sets through it. Besides
+// the predefined types, NewTwilightType creates phases with any elevations.
 type TwilightType int
 
 const (
@@ -25,33 +34,156 @@ const (
 	// observations, though the Sun's light may still interfere with observing
 	// extremely faint objects. Beyond astronomical twilight is true night.
 	Astronomical
+
+	// GoldenHour occurs when the sun is between 6° above and 4° below the horizon.
+	// The low sun gives the warm, soft, directional light favored by photographers.
+	GoldenHour
+
+	// BlueHour occurs when the sun is between 4° and 6° below the horizon, after
+	// the golden hour in the evening and before it in the morning. The sky takes
+	// on a deep blue color while artificial lights are already visible.
+	BlueHour
+
+	// Religious15 twilight occurs when the sun is between 0° and 15° below the
+	// horizon. Its dawn and dusk mark the Fajr and Isha prayer times of the
+	// Islamic Society of North America, among other religious calendars.
+	Religious15
+
+	// Religious19Half twilight occurs when the sun is between 0° and 19.5° below
+	// the horizon. Its dawn marks the Fajr prayer time of the Egyptian General
+	// Authority of Survey, among other religious calendars.
+	Religious19Half
 )
 
-// twilightAngle returns the solar elevation angle for the given twilight type.
-func twilightAngle(t TwilightType) float64 {
+// twilightElevations is the number of elevations, in hundredths of a degree
+// from -90° to 90°, that a custom twilight type can hold.
+const twilightElevations = 18001
+
+// NewTwilightType returns the twilight type of the phase between two solar
+// elevations, which can be passed to every function that accepts a
+// TwilightType. Dawn and dusk are the times at which the sun crosses the lower
+// elevation; MorningTwilight and EveningTwilight return both boundaries.
+//
+// The elevations are held in the value itself, to the hundredth of a degree,
+// so the type needs no registration and equal elevations give equal types.
+//
+// Parameters:
+//   - upper: Upper solar elevation angle in degrees (negative for below horizon), from -90 to 90
+//   - lower: Lower solar elevation angle in degrees; swapped with upper if higher
+//
+// Example:
+//
+//	// Tzeit hakochavim (nightfall) at 8.5° below the horizon
+//	tzeit := solar.NewTwilightType(solar.CivilTwilightAngle, -8.5)
+//	nightfall := solar.Dusk(loc, t, tzeit)
+func NewTwilightType(upper, lower float64) TwilightType {
+	if lower > upper {
+		upper, lower = lower, upper
+	}
+	// Custom types are negative, below the predefined ones
+	index := twilightCentidegrees(upper)*twilightElevations + twilightCentidegrees(lower)
+	return TwilightType(-1 - index)
+}
+
+// twilightCentidegrees returns an elevation in hundredths of a degree above
+// -90°, clamped to -90° to 90°.
+func twilightCentidegrees(elevation float64) int {
+	c := math.Round((elevation + 90) * 100)
+	if math.IsNaN(c) || c < 0 {
+		return 0
+	}
+	return int(math.Min(c, twilightElevations-1))
+}
+
+// String returns the name of the twilight type, or its elevations for a type
+// from NewTwilightType.
+func (t TwilightType) String() string {
 	switch t {
+	case Civil:
+		return "Civil"
 	case Nautical:
-		return NauticalTwilightAngle
+		return "Nautical"
 	case Astronomical:
-		return AstronomicalTwilightAngle
-	default: // Civil is the default
-		return CivilTwilightAngle
+		return "Astronomical"
+	case GoldenHour:
+		return "GoldenHour"
+	case BlueHour:
+		return "BlueHour"
+	case Religious15:
+		return "Religious15"
+	case Religious19Half:
+		return "Religious19Half"
 	}
+	if t < 0 {
+		upper, lower := t.Elevations()
+		return fmt.Sprintf("TwilightType(%g°, %g°)", upper, lower)
+	}
+	return fmt.Sprintf("TwilightType(%d)", int(t))
 }
 
-// dawnInternal is the internal implementation with old signature
-func dawnInternal(latitude, longitude float64, year int, month time.Month, day int, twilightType ...TwilightType) time.Time {
-	// Determine twilight type (default to Civil)
-	var tt TwilightType
+// Elevations returns the solar elevation angles, in degrees, between which the
+// twilight phase occurs. Dawn and dusk are the crossings of the lower one. It
+// panics for a value that is neither predefined nor from NewTwilightType.
+//
+// Example:
+//
+//	upper, lower := solar.GoldenHour.Elevations() // 6, -4
+func (t TwilightType) Elevations() (upper, lower float64) {
+	switch t {
+	case Civil:
+		return 0, CivilTwilightAngle
+	case Nautical:
+		return CivilTwilightAngle, NauticalTwilightAngle
+	case Astronomical:
+		return NauticalTwilightAngle, AstronomicalTwilightAngle
+	case GoldenHour:
+		return GoldenHourAngle, BlueHourAngle
+	case BlueHour:
+		return BlueHourAngle, CivilTwilightAngle
+	case Religious15:
+		return 0, Religious15TwilightAngle
+	case Religious19Half:
+		return 0, Religious19HalfTwilightAngle
+	}
+	if t < 0 {
+		index := -1 - int(t)
+		return float64(index/twilightElevations-9000) / 100, float64(index%twilightElevations-9000) / 100
+	}
+	panic(fmt.Sprintf("solar: invalid TwilightType %d", int(t)))
+}
+
+// selectTwilight returns the twilight type passed to a function, defaulting to
+// Civil.
+func selectTwilight(twilightType []TwilightType) TwilightType {
 	if len(twilightType) > 0 {
-		tt = twilightType[0]
-	} else {
-		tt = Civil
+		return twilightType[0]
 	}
+	return Civil
+}
 
-	// Calculate dawn using timeOfElevationInternal with the appropriate angle
-	dawn, _ := timeOfElevationInternal(latitude, longitude, twilightAngle(tt), year, month, day)
-	return dawn
+// twilightAngle returns the solar elevation angle of dawn and dusk for the
+// given twilight type.
+func twilightAngle(t TwilightType) float64 {
+	_, lower := t.Elevations()
+	return lower
+}
+
+// dawnInternal is the internal implementation with old signature, computed
+// with the given algorithm
+func dawnInternal(algorithm Algorithm, loc Location, year int, month time.Month, day int, twilightType ...TwilightType) (time.Time, error) {
+	tt := selectTwilight(twilightType)
+
+	// Calculate dawn using timeOfElevationAt with the appropriate angle
+	dawn, _, err := timeOfElevationAt(algorithm, loc, twilightAngle(tt), year, month, day)
+	return dawn, err
+}
+
+// dawnOnDay returns the dawn of the day of t, computed with the given
+// algorithm.
+func dawnOnDay(algorithm Algorithm, loc Location, t Time, twilightType []TwilightType) (time.Time, error) {
+	return onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		return dawnInternal(algorithm, loc, year, month, day, twilightType...)
+	})
 }
 
 // Dawn calculates the dawn time for a given location and date.
@@ -60,15 +192,18 @@ func dawnInternal(latitude, longitude float64, year int, month time.Month, day i
 //
 // By default, civil twilight (-6°) is used, which is the most common definition
 // of dawn in everyday contexts. You can optionally specify Nautical or Astronomical
-// twilight types for specialized applications.
+// twilight types for specialized applications, or any other twilight type, whose
+// dawn is the sun rising through its lower elevation. The location's algorithm
+// is used; DawnWith takes the algorithm for one call.
 //
 // Parameters:
 //   - loc: Location created via NewLocation() or NewLocationFromNMEA()
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
-//   - twilightType: Optional twilight type (Civil, Nautical, or Astronomical). Defaults to Civil.
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
 //
 // Returns:
-//   - Dawn time in UTC (time.Time{} if the sun never reaches the twilight angle on this day)
+//   - Dawn time in UTC, or in the time zone of t (time.Time{} if the sun never reaches the twilight angle on this day;
+//     use DawnErr to find out why)
 //
 // Example:
 //
@@ -83,22 +218,74 @@ func dawnInternal(latitude, longitude float64, year int, month time.Month, day i
 //	// Calculate astronomical dawn
 //	dawn := solar.Dawn(loc, t, solar.Astronomical)
 func Dawn(loc Location, t Time, twilightType ...TwilightType) time.Time {
-	return dawnInternal(loc.Latitude(), loc.Longitude(), t.Year(), t.Month(), t.Day(), twilightType...)
+	dawn, _ := DawnErr(loc, t, twilightType...)
+	return dawn
 }
 
-// duskInternal is the internal implementation with old signature
-func duskInternal(latitude, longitude float64, year int, month time.Month, day int, twilightType ...TwilightType) time.Time {
-	// Determine twilight type (default to Civil)
-	var tt TwilightType
-	if len(twilightType) > 0 {
-		tt = twilightType[0]
-	} else {
-		tt = Civil
-	}
+// DawnWith is like Dawn, but computes dawn with the given algorithm instead of
+// the location's.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Algorithm (SunriseEquation, NOAA or SPA)
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - Dawn time in UTC, or in the time zone of t (time.Time{} if the sun never reaches the twilight angle on this day)
+//
+// Example:
+//
+//	loc := solar.NewLocation(40.7128, -74.0060)
+//	t := solar.NewTime(2024, time.June, 21)
+//	dawn := solar.DawnWith(loc, t, solar.NOAA, solar.Nautical)
+func DawnWith(loc Location, t Time, algorithm Algorithm, twilightType ...TwilightType) time.Time {
+	dawn, _ := dawnOnDay(algorithm, loc, t, twilightType)
+	return dawn
+}
 
-	// Calculate dusk using timeOfElevationInternal with the appropriate angle
-	_, dusk := timeOfElevationInternal(latitude, longitude, twilightAngle(tt), year, month, day)
-	return dusk
+// DawnErr is like Dawn, but reports why there is no dawn instead of returning
+// a zero time.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - Dawn time in UTC, or in the time zone of t
+//   - error: an *ElevationError if the sun stays above the twilight angle all
+//     day (a white night) or below it (a polar night), or ErrNoEventOnDay if
+//     dawn falls outside the local civil day of t
+//
+// Example:
+//
+//	loc := solar.NewLocation(51.5072, -0.1276) // London
+//	t := solar.NewTime(2024, time.June, 21)
+//	dawn, err := solar.DawnErr(loc, t, solar.Astronomical)
+//	if errors.Is(err, solar.ErrSunNeverSets) {
+//	    // The sun never sinks to -18°: twilight lasts all night
+//	}
+func DawnErr(loc Location, t Time, twilightType ...TwilightType) (time.Time, error) {
+	return dawnOnDay(loc.Algorithm(), loc, t, twilightType)
+}
+
+// duskInternal is the internal implementation with old signature, computed
+// with the given algorithm
+func duskInternal(algorithm Algorithm, loc Location, year int, month time.Month, day int, twilightType ...TwilightType) (time.Time, error) {
+	tt := selectTwilight(twilightType)
+
+	// Calculate dusk using timeOfElevationAt with the appropriate angle
+	_, dusk, err := timeOfElevationAt(algorithm, loc, twilightAngle(tt), year, month, day)
+	return dusk, err
+}
+
+// duskOnDay returns the dusk of the day of t, computed with the given
+// algorithm.
+func duskOnDay(algorithm Algorithm, loc Location, t Time, twilightType []TwilightType) (time.Time, error) {
+	return onDay(t, func(year int, month time.Month, day int) (time.Time, error) {
+		return duskInternal(algorithm, loc, year, month, day, twilightType...)
+	})
 }
 
 // Dusk calculates the dusk time for a given location and date.
@@ -107,15 +294,18 @@ func duskInternal(latitude, longitude float64, year int, month time.Month, day i
 //
 // By default, civil twilight (-6°) is used, which is the most common definition
 // of dusk in everyday contexts. You can optionally specify Nautical or Astronomical
-// twilight types for specialized applications.
+// twilight types for specialized applications, or any other twilight type, whose
+// dusk is the sun setting through its lower elevation. The location's algorithm
+// is used; DuskWith takes the algorithm for one call.
 //
 // Parameters:
 //   - loc: Location created via NewLocation() or NewLocationFromNMEA()
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
-//   - twilightType: Optional twilight type (Civil, Nautical, or Astronomical). Defaults to Civil.
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
 //
 // Returns:
-//   - Dusk time in UTC (time.Time{} if the sun never reaches the twilight angle on this day)
+//   - Dusk time in UTC, or in the time zone of t (time.Time{} if the sun never reaches the twilight angle on this day;
+//     use DuskErr to find out why)
 //
 // Example:
 //
@@ -130,37 +320,85 @@ func duskInternal(latitude, longitude float64, year int, month time.Month, day i
 //	// Calculate astronomical dusk
 //	dusk := solar.Dusk(loc, t, solar.Astronomical)
 func Dusk(loc Location, t Time, twilightType ...TwilightType) time.Time {
-	return duskInternal(loc.Latitude(), loc.Longitude(), t.Year(), t.Month(), t.Day(), twilightType...)
+	dusk, _ := DuskErr(loc, t, twilightType...)
+	return dusk
 }
 
-// dawnDuskInternal is the internal implementation with old signature
-func dawnDuskInternal(latitude, longitude float64, year int, month time.Month, day int, twilightType ...TwilightType) (dawn, dusk time.Time) {
-	// Determine twilight type (default to Civil)
-	var tt TwilightType
-	if len(twilightType) > 0 {
-		tt = twilightType[0]
-	} else {
-		tt = Civil
-	}
+// DuskWith is like Dusk, but computes dusk with the given algorithm instead of
+// the location's.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Algorithm (SunriseEquation, NOAA or SPA)
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - Dusk time in UTC, or in the time zone of t (time.Time{} if the sun never reaches the twilight angle on this day)
+//
+// Example:
+//
+//	loc := solar.NewLocation(40.7128, -74.0060)
+//	t := solar.NewTime(2024, time.June, 21)
+//	dusk := solar.DuskWith(loc, t, solar.NOAA, solar.Nautical)
+func DuskWith(loc Location, t Time, algorithm Algorithm, twilightType ...TwilightType) time.Time {
+	dusk, _ := duskOnDay(algorithm, loc, t, twilightType)
+	return dusk
+}
 
-	// Calculate both times using timeOfElevationInternal with the appropriate angle
-	return timeOfElevationInternal(latitude, longitude, twilightAngle(tt), year, month, day)
+// DuskErr is like Dusk, but reports why there is no dusk instead of returning
+// a zero time.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - Dusk time in UTC, or in the time zone of t
+//   - error: an *ElevationError if the sun stays above the twilight angle all
+//     day (a white night) or below it (a polar night), or ErrNoEventOnDay if
+//     dusk falls outside the local civil day of t
+//
+// Example:
+//
+//	loc := solar.NewLocation(78.22, 15.65) // Longyearbyen
+//	t := solar.NewTime(2024, time.December, 21)
+//	dusk, err := solar.DuskErr(loc, t)
+//	if errors.Is(err, solar.ErrSunNeverRises) {
+//	    // The sun never climbs to -6°: no civil twilight at all
+//	}
+func DuskErr(loc Location, t Time, twilightType ...TwilightType) (time.Time, error) {
+	return duskOnDay(loc.Algorithm(), loc, t, twilightType)
+}
+
+// dawnDuskInternal is the internal implementation with old signature, computed
+// with the given algorithm
+func dawnDuskInternal(algorithm Algorithm, loc Location, year int, month time.Month, day int, twilightType ...TwilightType) (dawn, dusk time.Time, err error) {
+	tt := selectTwilight(twilightType)
+
+	// Calculate both times using timeOfElevationAt with the appropriate angle
+	return timeOfElevationAt(algorithm, loc, twilightAngle(tt), year, month, day)
 }
 
 // DawnDusk calculates both dawn and dusk times for a given location and date.
 // This is more efficient than calling Dawn() and Dusk() separately.
 //
 // By default, civil twilight (-6°) is used. You can optionally specify Nautical
-// or Astronomical twilight types for specialized applications.
+// or Astronomical twilight types for specialized applications. The location's
+// algorithm is used; unlike Sunrise and Sunset, DawnDusk takes no algorithm
+// argument, since the variadic parameter is the twilight type, so DawnDuskWith
+// takes the algorithm for one call. Use DawnDuskErr to find out why dawn or
+// dusk does not occur.
 //
 // Parameters:
 //   - loc: Location created via NewLocation() or NewLocationFromNMEA()
-//   - t: Time created via NewTime(), NewTimeFromDateTime(), or NewTimeFromNMEA()
-//   - twilightType: Optional twilight type (Civil, Nautical, or Astronomical). Defaults to Civil.
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
 //
 // Returns:
-//   - dawn: Dawn time in UTC (time.Time{} if never occurs)
-//   - dusk: Dusk time in UTC (time.Time{} if never occurs)
+//   - dawn: Dawn time in UTC, or in the time zone of t (time.Time{} if never occurs)
+//   - dusk: Dusk time in UTC, or in the time zone of t (time.Time{} if never occurs)
 //
 // Example:
 //
@@ -172,5 +410,142 @@ func dawnDuskInternal(latitude, longitude float64, year int, month time.Month, d
 //	// Calculate nautical dawn and dusk
 //	dawn, dusk := solar.DawnDusk(loc, t, solar.Nautical)
 func DawnDusk(loc Location, t Time, twilightType ...TwilightType) (dawn, dusk time.Time) {
-	return dawnDuskInternal(loc.Latitude(), loc.Longitude(), t.Year(), t.Month(), t.Day(), twilightType...)
+	return DawnDuskWith(loc, t, loc.Algorithm(), twilightType...)
+}
+
+// DawnDuskWith is like DawnDusk, but computes dawn and dusk with the given
+// algorithm instead of the location's.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - algorithm: Algorithm (SunriseEquation, NOAA or SPA)
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - dawn: Dawn time in UTC, or in the time zone of t (time.Time{} if never occurs)
+//   - dusk: Dusk time in UTC, or in the time zone of t (time.Time{} if never occurs)
+//
+// Example:
+//
+//	loc := solar.NewLocation(40.7128, -74.0060)
+//	t := solar.NewTime(2024, time.June, 21)
+//	// Calculate civil dawn and dusk with the NOAA algorithm
+//	dawn, dusk := solar.DawnDuskWith(loc, t, solar.NOAA)
+//
+//	// Calculate nautical dawn and dusk with SPA
+//	dawn, dusk = solar.DawnDuskWith(loc, t, solar.SPA, solar.Nautical)
+func DawnDuskWith(loc Location, t Time, algorithm Algorithm, twilightType ...TwilightType) (dawn, dusk time.Time) {
+	if t.zone == nil {
+		dawn, dusk, _ = dawnDuskInternal(algorithm, loc, t.Year(), t.Month(), t.Day(), twilightType...)
+		return dawn, dusk
+	}
+
+	// The dawn and dusk of a local day may come from different UTC dates
+	return DawnWith(loc, t, algorithm, twilightType...), DuskWith(loc, t, algorithm, twilightType...)
+}
+
+// DawnDuskErr is like DawnDusk, but reports why there is no dawn or dusk
+// instead of returning zero times. Both times are zero if either is missing.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - dawn: Dawn time in UTC, or in the time zone of t
+//   - dusk: Dusk time in UTC, or in the time zone of t
+//   - error: an *ElevationError if the sun stays above the twilight angle all
+//     day (a white night) or below it (a polar night), or ErrNoEventOnDay if
+//     dawn or dusk falls outside the local civil day of t
+//
+// Example:
+//
+//	loc := solar.NewLocation(51.5072, -0.1276)
+//	t := solar.NewTime(2024, time.June, 21)
+//	dawn, dusk, err := solar.DawnDuskErr(loc, t, solar.Astronomical)
+//	var elevErr *solar.ElevationError
+//	if errors.As(err, &elevErr) && elevErr.Above {
+//	    // White night: the sun stays above -18°
+//	}
+func DawnDuskErr(loc Location, t Time, twilightType ...TwilightType) (dawn, dusk time.Time, err error) {
+	if t.zone == nil {
+		return dawnDuskInternal(loc.Algorithm(), loc, t.Year(), t.Month(), t.Day(), twilightType...)
+	}
+
+	// The dawn and dusk of a local day may come from different UTC dates
+	if dawn, err = DawnErr(loc, t, twilightType...); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if dusk, err = DuskErr(loc, t, twilightType...); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return dawn, dusk, nil
+}
+
+// MorningTwilight calculates the beginning and end of a morning twilight phase
+// for a given location and date: the times at which the rising sun crosses the
+// lower and then the upper elevation of the twilight type. The morning golden
+// hour, for example, runs from the sun rising through -4° to its rising through
+// 6°. Both boundaries are computed as with TimeOfElevation.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - start: Beginning of the phase in UTC, or in the time zone of t
+//   - end: End of the phase in UTC, or in the time zone of t
+//   - error: an *ElevationError if the sun does not cross one of the elevations,
+//     or ErrNoEventOnDay if a boundary falls outside the local civil day of t
+//
+// Example:
+//
+//	loc := solar.NewLocation(40.7128, -74.0060)
+//	t := solar.NewTime(2024, time.June, 21)
+//	start, end, err := solar.MorningTwilight(loc, t, solar.BlueHour)
+func MorningTwilight(loc Location, t Time, twilightType ...TwilightType) (start, end time.Time, err error) {
+	upper, lower := selectTwilight(twilightType).Elevations()
+	if start, err = morningOfElevation(loc.Algorithm(), loc, lower, t); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end, err = morningOfElevation(loc.Algorithm(), loc, upper, t); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+// EveningTwilight calculates the beginning and end of an evening twilight phase
+// for a given location and date: the times at which the setting sun crosses the
+// upper and then the lower elevation of the twilight type. The evening golden
+// hour, for example, runs from the sun setting through 6° to its setting through
+// -4°. Both boundaries are computed as with TimeOfElevation.
+//
+// Parameters:
+//   - loc: Location created via NewLocation() or NewLocationFromNMEA()
+//   - t: Time created via NewTime(), NewTimeIn(), NewTimeFromDateTime(), or NewTimeFromNMEA()
+//   - twilightType: Optional twilight type (Civil, Nautical, Astronomical, another predefined type, or one from NewTwilightType). Defaults to Civil.
+//
+// Returns:
+//   - start: Beginning of the phase in UTC, or in the time zone of t
+//   - end: End of the phase in UTC, or in the time zone of t
+//   - error: an *ElevationError if the sun does not cross one of the elevations,
+//     or ErrNoEventOnDay if a boundary falls outside the local civil day of t
+//
+// Example:
+//
+//	loc := solar.NewLocation(40.7128, -74.0060)
+//	t := solar.NewTime(2024, time.June, 21)
+//	start, end, err := solar.EveningTwilight(loc, t, solar.GoldenHour)
+func EveningTwilight(loc Location, t Time, twilightType ...TwilightType) (start, end time.Time, err error) {
+	upper, lower := selectTwilight(twilightType).Elevations()
+	if start, err = eveningOfElevation(loc.Algorithm(), loc, upper, t); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end, err = eveningOfElevation(loc.Algorithm(), loc, lower, t); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
 }
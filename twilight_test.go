@@ -1,6 +1,7 @@
 package solar
 
 import (
+	"errors"
 	"math"
 	"testing"
 	"time"
@@ -88,6 +89,43 @@ func TestDawnDusk(t *testing.T) {
 	}
 }
 
+// TestDawnDuskWith checks that the algorithm given for one call overrides the
+// location's, for UTC dates and local days
+func TestDawnDuskWith(t *testing.T) {
+	loc := NewLocation(43.65, -79.38).WithAlgorithm(SPA) // Toronto
+	times := []Time{
+		NewTime(2024, time.June, 21),
+		NewTimeIn(2024, time.June, 21, time.FixedZone("EDT", -4*60*60)),
+	}
+
+	for _, algorithm := range []Algorithm{SunriseEquation, NOAA, SPA} {
+		for _, tm := range times {
+			want := loc.WithAlgorithm(algorithm)
+			wantDawn, wantDusk := DawnDusk(want, tm, Nautical)
+			if wantDawn.IsZero() || wantDusk.IsZero() {
+				t.Fatalf("DawnDusk(%s) returned zero times", algorithm)
+			}
+
+			dawn, dusk := DawnDuskWith(loc, tm, algorithm, Nautical)
+			if !dawn.Equal(wantDawn) || !dusk.Equal(wantDusk) {
+				t.Errorf("DawnDuskWith(%s) = %s, %s, want %s, %s", algorithm, dawn, dusk, wantDawn, wantDusk)
+			}
+			if got := DawnWith(loc, tm, algorithm, Nautical); !got.Equal(wantDawn) {
+				t.Errorf("DawnWith(%s) = %s, want %s", algorithm, got, wantDawn)
+			}
+			if got := DuskWith(loc, tm, algorithm, Nautical); !got.Equal(wantDusk) {
+				t.Errorf("DuskWith(%s) = %s, want %s", algorithm, got, wantDusk)
+			}
+		}
+	}
+
+	// The algorithms differ, so the one given is the one used
+	noaa := DawnWith(loc, times[0], NOAA)
+	if sunriseEquation := DawnWith(loc, times[0], SunriseEquation); noaa.Equal(sunriseEquation) {
+		t.Errorf("DawnWith(NOAA) = DawnWith(SunriseEquation) = %s", noaa)
+	}
+}
+
 // TestTwilightTypes tests all three twilight types
 func TestTwilightTypes(t *testing.T) {
 	// Toronto on June 21, 2024
@@ -159,6 +197,213 @@ func TestTwilightTypes(t *testing.T) {
 	}
 }
 
+// TestTwilightType_Elevations tests the elevation range and name of every
+// predefined twilight type
+func TestTwilightType_Elevations(t *testing.T) {
+	tests := []struct {
+		twilightType TwilightType
+		name         string
+		upper        float64
+		lower        float64
+	}{
+		{Civil, "Civil", 0, -6},
+		{Nautical, "Nautical", -6, -12},
+		{Astronomical, "Astronomical", -12, -18},
+		{GoldenHour, "GoldenHour", 6, -4},
+		{BlueHour, "BlueHour", -4, -6},
+		{Religious15, "Religious15", 0, -15},
+		{Religious19Half, "Religious19Half", 0, -19.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.twilightType.String(); got != tt.name {
+				t.Errorf("String() = %q, want %q", got, tt.name)
+			}
+			upper, lower := tt.twilightType.Elevations()
+			if upper != tt.upper || lower != tt.lower {
+				t.Errorf("Elevations() = %g, %g, want %g, %g", upper, lower, tt.upper, tt.lower)
+			}
+			if got := twilightAngle(tt.twilightType); got != tt.lower {
+				t.Errorf("twilightAngle() = %g, want %g", got, tt.lower)
+			}
+		})
+	}
+
+	// The zero value is civil twilight
+	var zero TwilightType
+	if upper, lower := zero.Elevations(); upper != 0 || lower != CivilTwilightAngle || zero.String() != "Civil" {
+		t.Errorf("TwilightType{} = %s %g, %g, want Civil", zero, upper, lower)
+	}
+	loc, tm := NewLocation(40.7128, -74.0060), NewTime(2024, time.June, 21)
+	if !Dawn(loc, tm, zero).Equal(Dawn(loc, tm)) {
+		t.Error("Dawn(TwilightType{}) differs from civil dawn")
+	}
+}
+
+// TestTwilightType_Extended tests that the new predefined types give the
+// crossings of their lower elevation everywhere a TwilightType is accepted
+func TestTwilightType_Extended(t *testing.T) {
+	loc := NewLocation(40.7128, -74.0060)
+	tm := NewTime(2024, time.March, 20)
+
+	for _, twilightType := range []TwilightType{GoldenHour, BlueHour, Religious15, Religious19Half} {
+		t.Run(twilightType.String(), func(t *testing.T) {
+			_, lower := twilightType.Elevations()
+			wantDawn, wantDusk := TimeOfElevation(loc, lower, tm)
+
+			if dawn := Dawn(loc, tm, twilightType); !dawn.Equal(wantDawn) {
+				t.Errorf("Dawn() = %s, want %s", dawn, wantDawn)
+			}
+			if dusk := Dusk(loc, tm, twilightType); !dusk.Equal(wantDusk) {
+				t.Errorf("Dusk() = %s, want %s", dusk, wantDusk)
+			}
+			dawn, dusk, err := DawnDuskErr(loc, tm, twilightType)
+			if err != nil || !dawn.Equal(wantDawn) || !dusk.Equal(wantDusk) {
+				t.Errorf("DawnDuskErr() = %s, %s, %v, want %s, %s", dawn, dusk, err, wantDawn, wantDusk)
+			}
+		})
+	}
+
+	// Fajr at -19.5° comes before Fajr at -15°, which comes before civil dawn
+	if !Dawn(loc, tm, Religious19Half).Before(Dawn(loc, tm, Religious15)) ||
+		!Dawn(loc, tm, Religious15).Before(Dawn(loc, tm)) {
+		t.Error("religious dawns out of order")
+	}
+}
+
+func TestNewTwilightType(t *testing.T) {
+	tzeit := NewTwilightType(CivilTwilightAngle, -8.5)
+	if upper, lower := tzeit.Elevations(); upper != CivilTwilightAngle || lower != -8.5 {
+		t.Errorf("Elevations() = %g, %g, want %g, -8.5", upper, lower, CivilTwilightAngle)
+	}
+	if got := tzeit.String(); got != "TwilightType(-6°, -8.5°)" {
+		t.Errorf("String() = %q, want TwilightType(-6°, -8.5°)", got)
+	}
+
+	// The elevations are put in order, and equal elevations give equal types
+	if swapped := NewTwilightType(-8.5, CivilTwilightAngle); swapped != tzeit {
+		t.Errorf("NewTwilightType() swapped = %v, want %v", swapped, tzeit)
+	}
+	if other := NewTwilightType(CivilTwilightAngle, -8.25); other == tzeit {
+		t.Error("NewTwilightType() with different elevations returned equal types")
+	}
+
+	// The elevations are kept to the hundredth of a degree, within -90° to 90°
+	tests := []struct {
+		upper, lower         float64
+		wantUpper, wantLower float64
+	}{
+		{-0.833, -0.8349, -0.83, -0.83},
+		{90, -90, 90, -90},
+		{120, -100, 90, -90},
+		{0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		upper, lower := NewTwilightType(tt.upper, tt.lower).Elevations()
+		if upper != tt.wantUpper || lower != tt.wantLower {
+			t.Errorf("NewTwilightType(%g, %g).Elevations() = %g, %g, want %g, %g",
+				tt.upper, tt.lower, upper, lower, tt.wantUpper, tt.wantLower)
+		}
+	}
+	if NewTwilightType(0, 0) == Civil {
+		t.Error("NewTwilightType(0, 0) is Civil")
+	}
+
+	// New York, end of Shabbat: the dusk of the custom type is the
+	// evening crossing of -8.5°
+	loc := NewLocation(40.7128, -74.006)
+	tm := NewTime(2022, time.November, 26)
+	_, want := TimeOfElevation(loc, -8.5, tm)
+	if dusk := Dusk(loc, tm, tzeit); !dusk.Equal(want) {
+		t.Errorf("Dusk(Tzeit) = %s, want %s", dusk, want)
+	}
+}
+
+// TestTwilightType_Invalid checks that a value that is neither predefined nor
+// from NewTwilightType is rejected rather than taken for civil twilight
+func TestTwilightType_Invalid(t *testing.T) {
+	invalid := Religious19Half + 1
+	if got := invalid.String(); got != "TwilightType(7)" {
+		t.Errorf("String() = %q, want TwilightType(7)", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Elevations() of an invalid type did not panic")
+		}
+	}()
+	invalid.Elevations()
+}
+
+// TestMorningEveningTwilight tests the boundaries of twilight phases
+func TestMorningEveningTwilight(t *testing.T) {
+	loc := NewLocation(40.7128, -74.0060)
+	tm := NewTime(2024, time.June, 21)
+
+	for _, twilightType := range []TwilightType{Civil, Nautical, Astronomical, GoldenHour, BlueHour, Religious15} {
+		t.Run(twilightType.String(), func(t *testing.T) {
+			upper, lower := twilightType.Elevations()
+			lowerMorning, lowerEvening := TimeOfElevation(loc, lower, tm)
+			upperMorning, upperEvening := TimeOfElevation(loc, upper, tm)
+
+			start, end, err := MorningTwilight(loc, tm, twilightType)
+			if err != nil {
+				t.Fatalf("MorningTwilight() error = %v", err)
+			}
+			if !start.Equal(lowerMorning) || !end.Equal(upperMorning) {
+				t.Errorf("MorningTwilight() = %s, %s, want %s, %s", start, end, lowerMorning, upperMorning)
+			}
+
+			start, end, err = EveningTwilight(loc, tm, twilightType)
+			if err != nil {
+				t.Fatalf("EveningTwilight() error = %v", err)
+			}
+			if !start.Equal(upperEvening) || !end.Equal(lowerEvening) {
+				t.Errorf("EveningTwilight() = %s, %s, want %s, %s", start, end, upperEvening, lowerEvening)
+			}
+		})
+	}
+
+	// The blue hour and the golden hour meet at -4°
+	_, blueEnd, _ := MorningTwilight(loc, tm, BlueHour)
+	goldenStart, _, _ := MorningTwilight(loc, tm, GoldenHour)
+	if !blueEnd.Equal(goldenStart) {
+		t.Errorf("morning blue hour ends %s, golden hour starts %s", blueEnd, goldenStart)
+	}
+	_, goldenEnd, _ := EveningTwilight(loc, tm, GoldenHour)
+	blueStart, _, _ := EveningTwilight(loc, tm, BlueHour)
+	if !goldenEnd.Equal(blueStart) {
+		t.Errorf("evening golden hour ends %s, blue hour starts %s", goldenEnd, blueStart)
+	}
+}
+
+func TestMorningEveningTwilight_Errors(t *testing.T) {
+	// London at midsummer: the sun never sinks to -18°
+	loc := NewLocation(51.5072, -0.1276)
+	tm := NewTime(2022, time.June, 21)
+	if _, _, err := MorningTwilight(loc, tm, Astronomical); !errors.Is(err, ErrSunNeverSets) {
+		t.Errorf("MorningTwilight(Astronomical) error = %v, want %v", err, ErrSunNeverSets)
+	}
+
+	// Longyearbyen at midwinter: the sun never climbs to the horizon
+	loc = NewLocation(78.22, 15.65)
+	tm = NewTime(2024, time.December, 21)
+	var elevErr *ElevationError
+	if _, _, err := EveningTwilight(loc, tm, Nautical); !errors.As(err, &elevErr) || elevErr.Above || elevErr.Elevation != CivilTwilightAngle {
+		t.Errorf("EveningTwilight(Nautical) error = %v, want the sun always below -6°", err)
+	}
+
+	// Zoned days return the boundaries in the zone
+	zone := time.FixedZone("EDT", -4*60*60)
+	start, end, err := EveningTwilight(NewLocation(40.7128, -74.0060), NewTimeIn(2024, time.June, 21, zone), GoldenHour)
+	if err != nil {
+		t.Fatalf("EveningTwilight(zoned) error = %v", err)
+	}
+	if start.Location() != zone || end.Location() != zone || !start.Before(end) {
+		t.Errorf("EveningTwilight(zoned) = %s, %s", start, end)
+	}
+}
+
 // TestDawnDusk_PolarRegions tests twilight in polar regions
 func TestDawnDusk_PolarRegions(t *testing.T) {
 	// Igloolik, Nunavut during summer
@@ -177,6 +422,80 @@ func TestDawnDusk_PolarRegions(t *testing.T) {
 	}
 }
 
+// TestDawnDuskErr tests that the error-returning variants tell a white night
+// from a polar night
+func TestDawnDuskErr(t *testing.T) {
+	tests := []struct {
+		name         string
+		location     Location
+		date         Time
+		twilightType TwilightType
+		above        bool
+	}{
+		{"London white night", NewLocation(51.5072, -0.1276), NewTime(2022, time.June, 21), Astronomical, true},
+		{"Igloolik midnight sun", NewLocation(69.3321443, -81.6781126), NewTime(2020, time.June, 25), Civil, true},
+		{"Longyearbyen polar night", NewLocation(78.22, 15.65), NewTime(2024, time.December, 21), Civil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := ErrSunNeverRises
+			if tt.above {
+				want = ErrSunNeverSets
+			}
+			elevation := twilightAngle(tt.twilightType)
+
+			check := func(name string, when time.Time, err error) {
+				t.Helper()
+				if !when.IsZero() {
+					t.Errorf("%s = %s, want a zero time", name, when)
+				}
+				if !errors.Is(err, want) {
+					t.Errorf("%s error = %v, want %v", name, err, want)
+				}
+				var elevErr *ElevationError
+				if !errors.As(err, &elevErr) || elevErr.Above != tt.above || elevErr.Elevation != elevation {
+					t.Errorf("%s error = %v, want elevation %g, above %t", name, err, elevation, tt.above)
+				}
+			}
+
+			dawn, err := DawnErr(tt.location, tt.date, tt.twilightType)
+			check("DawnErr", dawn, err)
+			dusk, err := DuskErr(tt.location, tt.date, tt.twilightType)
+			check("DuskErr", dusk, err)
+			dawn, dusk, err = DawnDuskErr(tt.location, tt.date, tt.twilightType)
+			check("DawnDuskErr dawn", dawn, err)
+			check("DawnDuskErr dusk", dusk, err)
+		})
+	}
+}
+
+// TestDawnDuskErr_Reached tests that the error-returning variants agree with
+// Dawn and Dusk when twilight occurs
+func TestDawnDuskErr_Reached(t *testing.T) {
+	loc := NewLocation(43.65, -79.38)
+	zone := time.FixedZone("EDT", -4*60*60)
+
+	for _, tm := range []Time{NewTime(2024, time.June, 21), NewTimeIn(2024, time.June, 21, zone)} {
+		dawn, dusk, err := DawnDuskErr(loc, tm, Nautical)
+		if err != nil {
+			t.Fatalf("DawnDuskErr() error = %v", err)
+		}
+		if want := Dawn(loc, tm, Nautical); !dawn.Equal(want) {
+			t.Errorf("DawnDuskErr() dawn = %s, want %s", dawn, want)
+		}
+		if want := Dusk(loc, tm, Nautical); !dusk.Equal(want) {
+			t.Errorf("DawnDuskErr() dusk = %s, want %s", dusk, want)
+		}
+	}
+
+	// A zoned day reports the error of the UTC date of local noon
+	london := NewTimeIn(2022, time.June, 21, time.FixedZone("BST", 60*60))
+	if _, _, err := DawnDuskErr(NewLocation(51.5072, -0.1276), london, Astronomical); !errors.Is(err, ErrSunNeverSets) {
+		t.Errorf("DawnDuskErr(zoned white night) error = %v, want %v", err, ErrSunNeverSets)
+	}
+}
+
 // TestDawnFromNMEA_RMC tests dawn calculation from RMC sentence
 func TestDawnFromNMEA_RMC(t *testing.T) {
 	// Toronto location: Jan 1, 2000